@@ -0,0 +1,181 @@
+package app
+
+import (
+	"errors"
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	tokenkeeper "omnis/x/token/keeper"
+	tokentypes "omnis/x/token/types"
+)
+
+// HandlerOptions extends the SDK AnteHandler options with the keepers required
+// by the app specific decorators.
+type HandlerOptions struct {
+	ante.HandlerOptions
+
+	TokenKeeper *tokenkeeper.Keeper
+}
+
+// NewAnteHandler returns the SDK default AnteHandler chain, with fee deduction
+// replaced by the FeeAbstractionDecorator so that fees can be paid in
+// governance-whitelisted OMS-20 tokens.
+func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
+	if options.AccountKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "account keeper is required for ante builder")
+	}
+
+	if options.BankKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "bank keeper is required for ante builder")
+	}
+
+	if options.SignModeHandler == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
+	}
+
+	if options.TokenKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "token keeper is required for ante builder")
+	}
+
+	anteDecorators := []sdk.AnteDecorator{
+		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+		ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
+		ante.NewValidateBasicDecorator(),
+		ante.NewTxTimeoutHeightDecorator(),
+		ante.NewValidateMemoDecorator(options.AccountKeeper),
+		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
+		NewFeeAbstractionDecorator(
+			options.AccountKeeper,
+			*options.TokenKeeper,
+			ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
+		),
+		ante.NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
+		ante.NewValidateSigCountDecorator(options.AccountKeeper),
+		ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
+		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler, options.SigVerifyOptions...),
+		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
+	}
+
+	return sdk.ChainAnteDecorators(anteDecorators...), nil
+}
+
+// FeeAbstractionDecorator deducts fees paid in a whitelisted OMS-20 denom by
+// converting them to the native fee denom through the token module, so that
+// the fee collector is always credited in the native denom. Transactions
+// paying fees in any other denom are handed to the wrapped DeductFeeDecorator.
+type FeeAbstractionDecorator struct {
+	accountKeeper      ante.AccountKeeper
+	tokenKeeper        tokenkeeper.Keeper
+	deductFeeDecorator ante.DeductFeeDecorator
+}
+
+func NewFeeAbstractionDecorator(ak ante.AccountKeeper, tk tokenkeeper.Keeper, dfd ante.DeductFeeDecorator) FeeAbstractionDecorator {
+	return FeeAbstractionDecorator{
+		accountKeeper:      ak,
+		tokenKeeper:        tk,
+		deductFeeDecorator: dfd,
+	}
+}
+
+func (fad FeeAbstractionDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	fee := feeTx.GetFee()
+	if len(fee) != 1 || !fad.tokenKeeper.IsFeeToken(ctx, fee[0].Denom) {
+		return fad.deductFeeDecorator.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	gas := feeTx.GetGas()
+	if !simulate && ctx.BlockHeight() > 0 && gas == 0 {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidGasLimit, "must provide positive gas")
+	}
+
+	if feeTx.FeeGranter() != nil {
+		return ctx, sdkerrors.ErrInvalidRequest.Wrap("fee grants cannot be used to pay fees in OMS-20 tokens")
+	}
+
+	nativeFee, _, err := fad.tokenKeeper.ConvertFeeToNative(ctx, fee[0])
+	if err != nil {
+		return ctx, err
+	}
+
+	var priority int64
+	if !simulate {
+		if ctx.IsCheckTx() {
+			if err := checkMinGasPrices(ctx, nativeFee, gas); err != nil {
+				return ctx, err
+			}
+		}
+		priority = txPriority(nativeFee, gas)
+	}
+
+	if addr := fad.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName); addr == nil {
+		return ctx, errors.New("fee collector module account has not been set")
+	}
+
+	feePayer := sdk.AccAddress(feeTx.FeePayer())
+	if fad.accountKeeper.GetAccount(ctx, feePayer) == nil {
+		return ctx, sdkerrors.ErrUnknownAddress.Wrapf("fee payer address: %s does not exist", feePayer)
+	}
+
+	if _, err := fad.tokenKeeper.PayFeeInToken(ctx, feePayer, fee[0], authtypes.FeeCollectorName); err != nil {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrInsufficientFunds, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeTx,
+			sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
+			sdk.NewAttribute(sdk.AttributeKeyFeePayer, feePayer.String()),
+			sdk.NewAttribute(tokentypes.AttributeKeyEffectiveFee, nativeFee.String()),
+		),
+	)
+
+	return next(ctx.WithPriority(priority), tx, simulate)
+}
+
+// checkMinGasPrices ensures the native equivalent of the fee satisfies the
+// validator's minimum gas prices in the native denom.
+func checkMinGasPrices(ctx sdk.Context, nativeFee sdk.Coin, gas uint64) error {
+	minGasPrices := ctx.MinGasPrices()
+	if minGasPrices.IsZero() {
+		return nil
+	}
+
+	glDec := sdkmath.LegacyNewDec(int64(gas))
+	for _, gp := range minGasPrices {
+		if gp.Denom != nativeFee.Denom {
+			continue
+		}
+		required := gp.Amount.Mul(glDec).Ceil().RoundInt()
+		if nativeFee.Amount.LT(required) {
+			return errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s converted to %s required: %s%s",
+				nativeFee, nativeFee.Denom, required, gp.Denom)
+		}
+		return nil
+	}
+
+	return errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "no minimum gas price set for native fee denom %s", nativeFee.Denom)
+}
+
+// txPriority mirrors the SDK default priority, the gas price in the native
+// fee denom.
+func txPriority(nativeFee sdk.Coin, gas uint64) int64 {
+	if gas == 0 {
+		return 0
+	}
+	p := nativeFee.Amount.QuoRaw(int64(gas))
+	if !p.IsInt64() {
+		return math.MaxInt64
+	}
+	return p.Int64()
+}
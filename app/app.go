@@ -12,6 +12,8 @@ import (
 	circuitkeeper "cosmossdk.io/x/circuit/keeper"
 	upgrade "cosmossdk.io/x/upgrade"
 	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
+	"cosmossdk.io/x/nft"
+	nftkeeper "cosmossdk.io/x/nft/keeper"
 	abci "github.com/cometbft/cometbft/abci/types"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -25,6 +27,7 @@ import (
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 
 	// Cosmos SDK modules using cosmossdk.io paths
 	auth "cosmossdk.io/x/auth"
@@ -132,6 +135,7 @@ type App struct {
 	CircuitBreakerKeeper circuitkeeper.Keeper
 	ParamsKeeper        paramskeeper.Keeper
 	FeeGrantKeeper      feegrantkeeper.Keeper // Added
+	NFTKeeper           nftkeeper.Keeper
 
 	// ibc keepers
 	IBCKeeper           *ibckeeper.Keeper
@@ -182,9 +186,13 @@ func New(
 	app := &App{}
 	appBuilder := &runtime.AppBuilder{}
 
+	// x/nft is wired outside of the app config, see registerNFTModule
+	nftStoreKey := storetypes.NewKVStoreKey(nft.StoreKey)
+
 	// merge the AppConfig and other configuration in one config
 	appConfig := depinject.Configs(
 		AppConfig(),
+		depinject.Provide(ProvideNFTKeeper(nftStoreKey)),
 		depinject.Supply(
 			// supply app options
 			appopts,
@@ -195,6 +203,10 @@ func New(
 			// for instance supplying a custom address codec for not using bech32 addresses.
 			// read the depinject documentation and depinject module wiring for more information
 			// on available options and how to use them.
+
+			// supply the IBC keeper getter, the IBC keeper is created after
+			// the dependency injected modules
+			app.GetIBCKeeper,
 		),
 	)
 
@@ -221,19 +233,12 @@ func New(
 		&app.OmnisKeeper,
 		&app.TokenKeeper,
 		&app.FeeGrantKeeper, // <--- Added FeeGrantKeeper to inject
+		&app.NFTKeeper,
 		// this line is used by starport scaffolding # stargate/app/keeperDeclaration
 	); err != nil {
 		panic(err)
 	}
 
-	// Initialize TokenKeeper after other core keepers are available
-	app.TokenKeeper = tokenkeeper.NewKeeper(
-		app.AuthKeeper.AddressCodec(),
-		app.BaseApp.StoreService(),
-		app.BankKeeper,
-		app.AuthKeeper,
-	)
-
 	// add to default baseapp options
 	// enable optimistic execution
 	baseAppOptions = append(baseAppOptions, baseapp.SetOptimisticExecution())
@@ -243,6 +248,16 @@ func New(
 		baseAppOptions...,
 	)
 
+	// set the app ante handler so that fees can be paid in whitelisted OMS-20 tokens
+	if err := app.setAnteHandler(); err != nil {
+		panic(err)
+	}
+
+	// register x/nft with a Msg service that moves the mirrored items
+	if err := app.registerNFTModule(nftStoreKey); err != nil {
+		panic(err)
+	}
+
 	// Define the module manager with all your modules
 	app.mm = module.NewManager(
 		genutil.NewAppModule(
@@ -315,6 +330,31 @@ func New(
 	return app
 }
 
+// setAnteHandler sets the app ante handler, see NewAnteHandler.
+func (app *App) setAnteHandler() error {
+	anteHandler, err := NewAnteHandler(HandlerOptions{
+		HandlerOptions: ante.HandlerOptions{
+			AccountKeeper:   app.AuthKeeper,
+			BankKeeper:      app.BankKeeper,
+			FeegrantKeeper:  app.FeeGrantKeeper,
+			SignModeHandler: app.txConfig.SignModeHandler(),
+			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+		},
+		TokenKeeper: &app.TokenKeeper,
+	})
+	if err != nil {
+		return err
+	}
+
+	app.SetAnteHandler(anteHandler)
+	return nil
+}
+
+// GetIBCKeeper returns the IBC keeper.
+func (app *App) GetIBCKeeper() *ibckeeper.Keeper {
+	return app.IBCKeeper
+}
+
 // GetSubspace returns a param subspace for a given module name.
 func (app *App) GetSubspace(moduleName string) paramstypes.Subspace {
 	subspace, _ := app.ParamsKeeper.GetSubspace(moduleName)
@@ -30,6 +30,9 @@ import (
 	ibckeeper "github.com/cosmos/ibc-go/v10/modules/core/keeper"
 	solomachine "github.com/cosmos/ibc-go/v10/modules/light-clients/06-solomachine"
 	ibctm "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+
+	omnismodule "omnis/x/omnis/module"
+	omnismoduletypes "omnis/x/omnis/types"
 )
 
 // registerIBCModules register IBC keepers and non dependency inject modules.
@@ -105,13 +108,15 @@ func (app *App) registerIBCModules(appOpts servertypes.AppOptions) error {
 		transferStackV2    ibcapi.IBCModule    = ibctransferv2.NewIBCModule(app.TransferKeeper)
 		icaControllerStack porttypes.IBCModule = icacontroller.NewIBCMiddleware(app.ICAControllerKeeper)
 		icaHostStack       porttypes.IBCModule = icahost.NewIBCModule(app.ICAHostKeeper)
+		omnisStack         porttypes.IBCModule = omnismodule.NewIBCModule(app.OmnisKeeper)
 	)
 
-	// create IBC v1 router, add transfer route, then set it on the keeper
+	// create IBC v1 router, add transfer, ICA and ICS-721 routes, then set it on the keeper
 	ibcRouter := porttypes.NewRouter().
 		AddRoute(ibctransfertypes.ModuleName, transferStack).
 		AddRoute(icacontrollertypes.SubModuleName, icaControllerStack).
-		AddRoute(icahosttypes.SubModuleName, icaHostStack)
+		AddRoute(icahosttypes.SubModuleName, icaHostStack).
+		AddRoute(omnismoduletypes.PortID, omnisStack)
 
 	// create IBC v2 router, add transfer route, then set it on the keeper
 	ibcv2Router := ibcapi.NewRouter().
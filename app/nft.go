@@ -0,0 +1,76 @@
+package app
+
+import (
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/nft"
+	nftkeeper "cosmossdk.io/x/nft/keeper"
+	nftmodule "cosmossdk.io/x/nft/module"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"google.golang.org/grpc"
+
+	omniskeeper "omnis/x/omnis/keeper"
+)
+
+// ProvideNFTKeeper returns a depinject provider of the x/nft keeper, which
+// stores its state under storeKey. x/nft is not part of the app config so that
+// its Msg service can be wrapped, see registerNFTModule.
+func ProvideNFTKeeper(storeKey *storetypes.KVStoreKey) func(codec.Codec, nft.AccountKeeper, nft.BankKeeper) nftkeeper.Keeper {
+	return func(cdc codec.Codec, ak nft.AccountKeeper, bk nft.BankKeeper) nftkeeper.Keeper {
+		return nftkeeper.NewKeeper(runtime.NewKVStoreService(storeKey), cdc, ak, bk)
+	}
+}
+
+// registerNFTModule registers the x/nft store and module. Every x/nft MsgSend
+// hands the x/omnis item mirrored by the sent NFT over to its receiver, however
+// the message is routed: in a transaction, authz MsgExec, group or governance
+// proposals, or interchain account packets.
+func (app *App) registerNFTModule(storeKey *storetypes.KVStoreKey) error {
+	if err := app.RegisterStores(storeKey); err != nil {
+		return err
+	}
+
+	return app.RegisterModules(nftModule{
+		AppModule:   nftmodule.NewAppModule(app.appCodec, app.NFTKeeper, app.AuthKeeper, app.BankKeeper, app.interfaceRegistry),
+		keeper:      app.NFTKeeper,
+		omnisKeeper: app.OmnisKeeper,
+	})
+}
+
+// nftModule is the x/nft module with its Msg service wrapped by nftMsgServer.
+type nftModule struct {
+	nftmodule.AppModule
+
+	keeper      nftkeeper.Keeper
+	omnisKeeper omniskeeper.Keeper
+}
+
+// RegisterServices registers the x/nft query service and the wrapped Msg
+// service.
+func (am nftModule) RegisterServices(registrar grpc.ServiceRegistrar) error {
+	nft.RegisterMsgServer(registrar, nftMsgServer{MsgServer: am.keeper, omnisKeeper: am.omnisKeeper})
+	nft.RegisterQueryServer(registrar, am.keeper)
+	return nil
+}
+
+// nftMsgServer syncs the owner of x/omnis items with the NFTs mirroring them
+// once those are sent. A failed sync fails the send, which keeps the NFTs of
+// expired items from changing hands.
+type nftMsgServer struct {
+	nft.MsgServer
+
+	omnisKeeper omniskeeper.Keeper
+}
+
+func (s nftMsgServer) Send(ctx context.Context, msg *nft.MsgSend) (*nft.MsgSendResponse, error) {
+	res, err := s.MsgServer.Send(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.omnisKeeper.SyncNFTOwner(ctx, msg.ClassId, msg.Id); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
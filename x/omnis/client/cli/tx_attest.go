@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"omnis/x/omnis/types"
+)
+
+const FlagExpiresAt = "expires-at"
+
+// CmdAttest hashes a statement file locally and attests an item with its
+// hash, so that the statement itself never leaves the machine.
+func CmdAttest() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attest [id] [claim-type] [statement-file]",
+		Short: "Attest an item with the hash of a statement file",
+		Long: `Hash a statement file locally and attest the item with the given claim type.
+The file is never sent: only its digest goes on chain. Only the attesters of the
+collection of the item may attest it.`,
+		Example: fmt.Sprintf("%s tx %s attest 1 authentic ./certificate.pdf --expires-at 2027-01-01T00:00:00Z", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid item id %s: %w", args[0], err)
+			}
+			algorithmName, err := cmd.Flags().GetString(FlagAlgorithm)
+			if err != nil {
+				return err
+			}
+			payloadHash, err := HashFile(args[2], algorithmName)
+			if err != nil {
+				return err
+			}
+
+			var expiresAt *time.Time
+			expiry, err := cmd.Flags().GetString(FlagExpiresAt)
+			if err != nil {
+				return err
+			}
+			if expiry != "" {
+				t, err := time.Parse(time.RFC3339, expiry)
+				if err != nil {
+					return fmt.Errorf("invalid expiry time %s: %w", expiry, err)
+				}
+				expiresAt = &t
+			}
+
+			msg := types.NewMsgAttest(clientCtx.GetFromAddress().String(), id, args[1], payloadHash, expiresAt)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagAlgorithm, "sha256", "Hash algorithm, sha256 or blake2b-256")
+	cmd.Flags().String(FlagExpiresAt, "", "Optional end of validity of the attestation, as an RFC 3339 time")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,363 @@
+package cli
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"omnis/x/omnis/types"
+)
+
+const (
+	FlagBatchSize = "batch-size"
+
+	// csvAttributePrefix prefixes the CSV columns holding item attributes,
+	// as in attr:color or attr:weight:decimal.
+	csvAttributePrefix = "attr:"
+)
+
+// CmdBatchCreateItems creates the items described by a CSV or JSONL file,
+// chunked into as many transactions as needed.
+func CmdBatchCreateItems() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-create-items [file]",
+		Short: "Create the items described by a CSV or JSONL file",
+		Long: `Create the items described by a CSV or JSONL file, sending one MsgBatchCreateItems
+transaction per chunk of --batch-size items. The chunk size defaults to the max_batch_size param.
+Every chunk is created entirely or not at all, chunks already sent are not rolled back
+if a later one fails.
+
+A CSV file starts with a header naming its columns among name, alias, namespace,
+expires_at (RFC 3339), royalty_recipient and royalty_basis_points. The other columns
+hold attributes, as attr:<key> for string attributes or attr:<key>:<type> with type
+one of string, int, decimal, bool, timestamp or hash. Empty cells are ignored.
+
+A JSONL file holds one JSON encoded BatchItem per line.`,
+		Example: fmt.Sprintf(`%s tx %s batch-create-items ./inventory.csv --from alice
+$ cat inventory.csv
+namespace,name,alias,attr:color,attr:weight:decimal
+artworks,Sunset,sunset,orange,1.5
+artworks,Sunrise,,yellow,`, version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			items, err := ReadBatchItems(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+			batchSize, err := getBatchSize(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+
+			creator := clientCtx.GetFromAddress().String()
+			var msgs []sdk.Msg
+			for start := 0; start < len(items); start += batchSize {
+				end := min(start+batchSize, len(items))
+				msgs = append(msgs, types.NewMsgBatchCreateItems(creator, items[start:end]))
+			}
+			return broadcastChunks(cmd, clientCtx, msgs)
+		},
+	}
+
+	cmd.Flags().Int(FlagBatchSize, 0, "Number of items per transaction, defaults to the max_batch_size param")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdBatchUpdateItems renames the items listed in a CSV or JSONL file,
+// chunked into as many transactions as needed.
+func CmdBatchUpdateItems() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-update-items [file]",
+		Short: "Rename the items listed in a CSV or JSONL file",
+		Long: `Rename the items listed in a CSV or JSONL file, sending one MsgBatchUpdateItems
+transaction per chunk of --batch-size items. The chunk size defaults to the max_batch_size param.
+
+A CSV file starts with a header naming its id and new_name columns. A JSONL file holds
+one JSON encoded BatchItemUpdate per line.`,
+		Example: fmt.Sprintf(`%s tx %s batch-update-items ./renames.jsonl --from alice
+$ cat renames.jsonl
+{"id":"1","new_name":"Sunset"}
+{"id":"2","new_name":"Sunrise"}`, version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			updates, err := ReadBatchItemUpdates(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+			batchSize, err := getBatchSize(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+
+			creator := clientCtx.GetFromAddress().String()
+			var msgs []sdk.Msg
+			for start := 0; start < len(updates); start += batchSize {
+				end := min(start+batchSize, len(updates))
+				msgs = append(msgs, types.NewMsgBatchUpdateItems(creator, updates[start:end]))
+			}
+			return broadcastChunks(cmd, clientCtx, msgs)
+		},
+	}
+
+	cmd.Flags().Int(FlagBatchSize, 0, "Number of items per transaction, defaults to the max_batch_size param")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// getBatchSize returns the --batch-size flag, or the max_batch_size param
+// when it is not set. The default param is used offline.
+func getBatchSize(cmd *cobra.Command, clientCtx client.Context) (int, error) {
+	batchSize, err := cmd.Flags().GetInt(FlagBatchSize)
+	if err != nil {
+		return 0, err
+	}
+	if batchSize < 0 {
+		return 0, fmt.Errorf("invalid batch size %d", batchSize)
+	}
+	if batchSize > 0 {
+		return batchSize, nil
+	}
+	if clientCtx.Offline {
+		return int(types.DefaultMaxBatchSize), nil
+	}
+
+	res, err := types.NewQueryClient(clientCtx).Params(cmd.Context(), &types.QueryParamsRequest{})
+	if err != nil {
+		return 0, fmt.Errorf("failed to query the max batch size: %w", err)
+	}
+	return int(res.Params.MaxBatchSize), nil
+}
+
+// broadcastChunks sends every message in its own transaction. The sequence
+// of the signer is incremented locally, so that the transactions can be
+// broadcast without waiting for the previous ones to be committed.
+func broadcastChunks(cmd *cobra.Command, clientCtx client.Context, msgs []sdk.Msg) error {
+	txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+	if err != nil {
+		return err
+	}
+	if !clientCtx.GenerateOnly {
+		if txf, err = txf.Prepare(clientCtx); err != nil {
+			return err
+		}
+	}
+
+	for i, msg := range msgs {
+		if err := tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg); err != nil {
+			return fmt.Errorf("chunk %d of %d: %w", i+1, len(msgs), err)
+		}
+		txf = txf.WithSequence(txf.Sequence() + 1)
+	}
+	return nil
+}
+
+// ReadBatchItems reads the items of a CSV or JSONL file, depending on its
+// extension.
+func ReadBatchItems(cdc codec.Codec, path string) ([]types.BatchItem, error) {
+	var items []types.BatchItem
+	err := readBatchFile(path,
+		func(line []byte) error {
+			var item types.BatchItem
+			if err := cdc.UnmarshalJSON(line, &item); err != nil {
+				return err
+			}
+			items = append(items, item)
+			return nil
+		},
+		func(record map[string]string) error {
+			item, err := parseCSVItem(record)
+			if err != nil {
+				return err
+			}
+			items = append(items, item)
+			return nil
+		},
+	)
+	return items, err
+}
+
+// ReadBatchItemUpdates reads the item updates of a CSV or JSONL file,
+// depending on its extension.
+func ReadBatchItemUpdates(cdc codec.Codec, path string) ([]types.BatchItemUpdate, error) {
+	var updates []types.BatchItemUpdate
+	err := readBatchFile(path,
+		func(line []byte) error {
+			var update types.BatchItemUpdate
+			if err := cdc.UnmarshalJSON(line, &update); err != nil {
+				return err
+			}
+			updates = append(updates, update)
+			return nil
+		},
+		func(record map[string]string) error {
+			for column := range record {
+				if column != "id" && column != "new_name" {
+					return fmt.Errorf("unknown column %q", column)
+				}
+			}
+			id, err := strconv.ParseUint(record["id"], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid id: %w", err)
+			}
+			updates = append(updates, types.BatchItemUpdate{Id: id, NewName: record["new_name"]})
+			return nil
+		},
+	)
+	return updates, err
+}
+
+// readBatchFile hands every line of a .jsonl file, or every record of a .csv
+// file keyed by the columns of its header, to the matching callback.
+func readBatchFile(path string, onLine func([]byte) error, onRecord func(map[string]string) error) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	var count int
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".jsonl", ".ndjson":
+		scanner := bufio.NewScanner(file)
+		scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+		for line := 1; scanner.Scan(); line++ {
+			if len(strings.TrimSpace(scanner.Text())) == 0 {
+				continue
+			}
+			if err := onLine(scanner.Bytes()); err != nil {
+				return fmt.Errorf("%s:%d: %w", path, line, err)
+			}
+			count++
+		}
+		if err := scanner.Err(); err != nil {
+			return fmt.Errorf("failed to read %s: %w", path, err)
+		}
+	case ".csv":
+		reader := csv.NewReader(file)
+		reader.TrimLeadingSpace = true
+		header, err := reader.Read()
+		if err != nil {
+			return fmt.Errorf("failed to read the header of %s: %w", path, err)
+		}
+		for line := 2; ; line++ {
+			row, err := reader.Read()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return fmt.Errorf("failed to read %s: %w", path, err)
+			}
+			record := make(map[string]string, len(header))
+			for i, column := range header {
+				record[strings.TrimSpace(column)] = strings.TrimSpace(row[i])
+			}
+			if err := onRecord(record); err != nil {
+				return fmt.Errorf("%s:%d: %w", path, line, err)
+			}
+			count++
+		}
+	default:
+		return fmt.Errorf("unsupported file %s, expected a .csv or .jsonl file", path)
+	}
+
+	if count == 0 {
+		return fmt.Errorf("no items in %s", path)
+	}
+	return nil
+}
+
+// parseCSVItem builds an item from a CSV record keyed by the columns of the
+// header.
+func parseCSVItem(record map[string]string) (types.BatchItem, error) {
+	item := types.BatchItem{
+		Name:      record["name"],
+		Alias:     record["alias"],
+		Namespace: record["namespace"],
+	}
+	for column, value := range record {
+		switch column {
+		case "name", "alias", "namespace", "royalty_recipient", "royalty_basis_points":
+		case "expires_at":
+			if value == "" {
+				continue
+			}
+			expiresAt, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return types.BatchItem{}, fmt.Errorf("invalid expires_at: %w", err)
+			}
+			item.ExpiresAt = &expiresAt
+		default:
+			if !strings.HasPrefix(column, csvAttributePrefix) {
+				return types.BatchItem{}, fmt.Errorf("unknown column %q", column)
+			}
+			if value == "" {
+				continue
+			}
+			attribute, err := parseCSVAttribute(strings.TrimPrefix(column, csvAttributePrefix), value)
+			if err != nil {
+				return types.BatchItem{}, err
+			}
+			item.Attributes = append(item.Attributes, attribute)
+		}
+	}
+
+	if recipient := record["royalty_recipient"]; recipient != "" {
+		basisPoints, err := strconv.ParseUint(record["royalty_basis_points"], 10, 32)
+		if err != nil {
+			return types.BatchItem{}, fmt.Errorf("invalid royalty_basis_points: %w", err)
+		}
+		item.Royalty = &types.Royalty{Recipient: recipient, BasisPoints: uint32(basisPoints)}
+	}
+
+	// Columns are read from a map in random order, the attributes are sorted
+	// so that every run sends the same message
+	if len(item.Attributes) > 0 {
+		attributes, err := types.NormalizeAttributes(item.Attributes)
+		if err != nil {
+			return types.BatchItem{}, err
+		}
+		item.Attributes = attributes
+	}
+	return item, nil
+}
+
+// parseCSVAttribute parses an attribute from a column named key or key:type.
+func parseCSVAttribute(column, value string) (types.Attribute, error) {
+	key, typeName, found := strings.Cut(column, ":")
+	if !found {
+		typeName = "string"
+	}
+	typ, ok := types.AttributeType_value["ATTRIBUTE_TYPE_"+strings.ToUpper(typeName)]
+	if !ok || typ == int32(types.ATTRIBUTE_TYPE_UNSPECIFIED) {
+		return types.Attribute{}, fmt.Errorf("unknown type %q of attribute %s", typeName, key)
+	}
+	return types.Attribute{Key: key, Type: types.AttributeType(typ), Value: value}, nil
+}
@@ -0,0 +1,135 @@
+package cli
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/blake2b"
+
+	"omnis/x/omnis/types"
+)
+
+const (
+	FlagAlgorithm = "algorithm"
+	FlagURI       = "uri"
+	FlagName      = "name"
+	FlagAlias     = "alias"
+)
+
+// GetTxCmd returns the hand-written tx commands of the module. The commands
+// generated by autocli are added to it.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Transactions commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdNotarize(),
+		CmdBatchCreateItems(),
+		CmdBatchUpdateItems(),
+		CmdAttest(),
+	)
+
+	return cmd
+}
+
+// CmdNotarize hashes a file locally and notarizes its hash, so that the
+// document itself never leaves the machine.
+func CmdNotarize() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "notarize [namespace] [file]",
+		Short: "Notarize the hash of a file in a collection",
+		Long: `Hash a file locally and create an item holding the hash in the given collection.
+The file is never sent: only its digest, and the optional --uri, go on chain.`,
+		Example: fmt.Sprintf("%s tx %s notarize contracts ./contract.pdf --algorithm blake2b-256 --uri ipfs://bafy...", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			algorithmName, err := cmd.Flags().GetString(FlagAlgorithm)
+			if err != nil {
+				return err
+			}
+			contentHash, err := HashFile(args[1], algorithmName)
+			if err != nil {
+				return err
+			}
+
+			uri, err := cmd.Flags().GetString(FlagURI)
+			if err != nil {
+				return err
+			}
+			name, err := cmd.Flags().GetString(FlagName)
+			if err != nil {
+				return err
+			}
+			if name == "" {
+				name = filepath.Base(args[1])
+			}
+			alias, err := cmd.Flags().GetString(FlagAlias)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgNotarize(clientCtx.GetFromAddress().String(), args[0], name, alias, contentHash, uri)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagAlgorithm, "sha256", "Hash algorithm, sha256 or blake2b-256")
+	cmd.Flags().String(FlagURI, "", "Optional off-chain location of the document")
+	cmd.Flags().String(FlagName, "", "Name of the created item, defaults to the file name")
+	cmd.Flags().String(FlagAlias, "", "Optional alias of the created item")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// HashFile returns the content hash of a file computed with the named
+// algorithm.
+func HashFile(path string, algorithmName string) (types.ContentHash, error) {
+	var (
+		algorithm types.HashAlgorithm
+		hasher    hash.Hash
+	)
+	switch strings.ToLower(algorithmName) {
+	case "sha256":
+		algorithm, hasher = types.HASH_ALGORITHM_SHA256, sha256.New()
+	case "blake2b-256", "blake2b":
+		// blake2b.New256 only fails for keys longer than 64 bytes
+		algorithm = types.HASH_ALGORITHM_BLAKE2B_256
+		hasher, _ = blake2b.New256(nil)
+	default:
+		return types.ContentHash{}, fmt.Errorf("unsupported hash algorithm %q, expected sha256 or blake2b-256", algorithmName)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return types.ContentHash{}, err
+	}
+	defer file.Close()
+
+	if _, err := io.Copy(hasher, file); err != nil {
+		return types.ContentHash{}, fmt.Errorf("failed to hash %s: %w", path, err)
+	}
+
+	return types.ContentHash{Algorithm: algorithm, Hash: hex.EncodeToString(hasher.Sum(nil))}, nil
+}
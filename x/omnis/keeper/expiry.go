@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"omnis/x/omnis/types"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ProcessExpiredItems deletes or flags the items whose expiry time has been
+// reached, depending on the expiry action of their collection. At most
+// MaxExpirationsPerBlock items are processed per call, the others stay queued
+// for the next blocks.
+func (k Keeper) ProcessExpiredItems(ctx context.Context) error {
+	return k.processExpiryQueue(ctx, k.expiryQueue, k.expireItem)
+}
+
+// ProcessExpiredUsers ends the rentals whose expiry time has been reached. At
+// most MaxExpirationsPerBlock rentals are processed per call, the others stay
+// queued for the next blocks.
+func (k Keeper) ProcessExpiredUsers(ctx context.Context) error {
+	return k.processExpiryQueue(ctx, k.userExpiryQueue, k.expireItemUser)
+}
+
+// processExpiryQueue applies expire to the first MaxExpirationsPerBlock items
+// of an expiry queue whose time has been reached. Each item is processed in
+// its own cached context: an item that cannot be expired is logged and dropped
+// from the queue without any of its changes, so that it cannot halt the chain.
+func (k Keeper) processExpiryQueue(
+	ctx context.Context,
+	queue collections.KeySet[collections.Pair[time.Time, uint64]],
+	expire func(context.Context, uint64) error,
+) error {
+	keys, err := k.dueItems(ctx, queue)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, key := range keys {
+		cacheCtx, write := sdkCtx.CacheContext()
+		if err := expire(cacheCtx, key.K2()); err != nil {
+			k.Logger(ctx).Error("failed to expire item", "id", key.K2(), "error", err)
+			if err := queue.Remove(ctx, key); err != nil {
+				return err
+			}
+			continue
+		}
+		write()
+	}
+	return nil
+}
+
+// dueItems returns the keys of the first MaxExpirationsPerBlock items of an
+// expiry queue whose time has been reached. The queue is collected before
+// processing since the items are removed from it while processing.
+func (k Keeper) dueItems(ctx context.Context, queue collections.KeySet[collections.Pair[time.Time, uint64]]) ([]collections.Pair[time.Time, uint64], error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	rng := new(collections.Range[collections.Pair[time.Time, uint64]]).
+		EndInclusive(collections.Join(sdkCtx.BlockTime(), uint64(math.MaxUint64)))
+
+	var keys []collections.Pair[time.Time, uint64]
+	err = queue.Walk(ctx, rng, func(key collections.Pair[time.Time, uint64]) (bool, error) {
+		keys = append(keys, key)
+		return uint64(len(keys)) >= params.MaxExpirationsPerBlock, nil
+	})
+	return keys, err
+}
+
+func (k Keeper) expireItem(ctx context.Context, id uint64) error {
+	item, err := k.GetItem(ctx, id)
+	if err != nil {
+		return err
+	}
+	collection, err := k.ItemCollection.Get(ctx, item.Namespace)
+	if err != nil {
+		return err
+	}
+
+	deleted := collection.ExpiryAction == types.EXPIRY_ACTION_DELETE
+	if deleted {
+		if err := k.removeItem(ctx, item, types.CUSTODY_REASON_EXPIRED); err != nil {
+			return err
+		}
+	} else {
+		prev := item
+		item.Expired = true
+		if err := k.recordItemRevision(ctx, prev, &item, ""); err != nil {
+			return err
+		}
+		if err := k.SetItem(ctx, item); err != nil {
+			return err
+		}
+		// Expired items cannot change hands
+		if err := k.removeListing(ctx, item.Id); err != nil {
+			return err
+		}
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventItemExpired{
+		Id:      item.Id,
+		Owner:   item.Owner,
+		Deleted: deleted,
+	})
+}
+
+func (k Keeper) expireItemUser(ctx context.Context, id uint64) error {
+	item, err := k.GetItem(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	prev := item
+	item.User, item.UserExpiresAt = "", nil
+	if err := k.recordItemRevision(ctx, prev, &item, ""); err != nil {
+		return err
+	}
+	if err := k.SetItem(ctx, item); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventItemUserExpired{
+		Id:   item.Id,
+		User: prev.User,
+	})
+}
@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"omnis/x/omnis/keeper"
+	"omnis/x/omnis/types"
+)
+
+func TestProcessExpiredItems(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	newOwner, err := f.addressCodec.BytesToString([]byte("newOwnerAddr________________"))
+	require.NoError(t, err)
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+
+	// Tickets are deleted when they expire, permits are kept
+	_, err = srv.CreateCollection(ctx, &types.MsgCreateCollection{
+		Creator:        creator,
+		Namespace:      "tickets",
+		CreationPolicy: types.CREATION_POLICY_OPEN,
+		ExpiryAction:   types.EXPIRY_ACTION_DELETE,
+	})
+	require.NoError(t, err)
+	_, err = srv.CreateCollection(ctx, &types.MsgCreateCollection{
+		Creator:        creator,
+		Namespace:      "permits",
+		CreationPolicy: types.CREATION_POLICY_OPEN,
+	})
+	require.NoError(t, err)
+
+	past := now.Add(-time.Hour)
+	_, err = srv.CreateItem(ctx, &types.MsgCreateItem{Creator: creator, Namespace: "tickets", ExpiresAt: &past})
+	require.ErrorIs(t, err, types.ErrInvalidExpiry)
+
+	expiresAt := now.Add(time.Hour)
+	ticket, err := srv.CreateItem(ctx, &types.MsgCreateItem{Creator: creator, Namespace: "tickets", ExpiresAt: &expiresAt})
+	require.NoError(t, err)
+	permit, err := srv.CreateItem(ctx, &types.MsgCreateItem{Creator: creator, Namespace: "permits", ExpiresAt: &expiresAt})
+	require.NoError(t, err)
+	kept, err := srv.CreateItem(ctx, &types.MsgCreateItem{Creator: creator, Namespace: "permits", ExpiresAt: &expiresAt})
+	require.NoError(t, err)
+
+	// Clearing the expiry takes the item out of the queue
+	_, err = srv.SetItemExpiry(ctx, &types.MsgSetItemExpiry{Creator: creator, Id: kept.Id})
+	require.NoError(t, err)
+
+	// Nothing expires before the expiry time
+	require.NoError(t, f.keeper.ProcessExpiredItems(ctx))
+	found, err := f.keeper.HasItem(ctx, ticket.Id)
+	require.NoError(t, err)
+	require.True(t, found)
+
+	// Only one item is processed per block
+	params := types.DefaultParams()
+	params.MaxExpirationsPerBlock = 1
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	ctx = ctx.WithBlockTime(expiresAt)
+	require.NoError(t, f.keeper.ProcessExpiredItems(ctx))
+	found, err = f.keeper.HasItem(ctx, ticket.Id)
+	require.NoError(t, err)
+	require.False(t, found)
+	item, err := f.keeper.GetItem(ctx, permit.Id)
+	require.NoError(t, err)
+	require.False(t, item.Expired)
+
+	require.NoError(t, f.keeper.ProcessExpiredItems(ctx))
+	item, err = f.keeper.GetItem(ctx, permit.Id)
+	require.NoError(t, err)
+	require.True(t, item.Expired)
+
+	item, err = f.keeper.GetItem(ctx, kept.Id)
+	require.NoError(t, err)
+	require.False(t, item.Expired)
+
+	// Expired items can no longer change hands or be renewed
+	_, err = srv.TransferItem(ctx, &types.MsgTransferItem{Creator: creator, Id: permit.Id, NewOwner: newOwner})
+	require.ErrorIs(t, err, types.ErrItemExpired)
+	renewal := expiresAt.Add(time.Hour)
+	_, err = srv.SetItemExpiry(ctx, &types.MsgSetItemExpiry{Creator: creator, Id: permit.Id, ExpiresAt: &renewal})
+	require.ErrorIs(t, err, types.ErrItemExpired)
+}
+
+func TestProcessExpiredItemsFailure(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+
+	for _, namespace := range []string{"tickets", "permits"} {
+		_, err = srv.CreateCollection(ctx, &types.MsgCreateCollection{
+			Creator:        creator,
+			Namespace:      namespace,
+			CreationPolicy: types.CREATION_POLICY_OPEN,
+		})
+		require.NoError(t, err)
+	}
+	expiresAt := now.Add(time.Hour)
+	broken, err := srv.CreateItem(ctx, &types.MsgCreateItem{Creator: creator, Namespace: "tickets", ExpiresAt: &expiresAt})
+	require.NoError(t, err)
+	permit, err := srv.CreateItem(ctx, &types.MsgCreateItem{Creator: creator, Namespace: "permits", ExpiresAt: &expiresAt})
+	require.NoError(t, err)
+
+	// An item whose collection is missing cannot be expired, it is dropped
+	// from the queue without halting the processing of the others
+	collection, err := f.keeper.ItemCollection.Get(ctx, "tickets")
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.ItemCollection.Remove(ctx, "tickets"))
+
+	ctx = ctx.WithBlockTime(expiresAt)
+	require.NoError(t, f.keeper.ProcessExpiredItems(ctx))
+	item, err := f.keeper.GetItem(ctx, permit.Id)
+	require.NoError(t, err)
+	require.True(t, item.Expired)
+	item, err = f.keeper.GetItem(ctx, broken.Id)
+	require.NoError(t, err)
+	require.False(t, item.Expired)
+
+	// The dropped item is not retried
+	require.NoError(t, f.keeper.ItemCollection.Set(ctx, "tickets", collection))
+	require.NoError(t, f.keeper.ProcessExpiredItems(ctx))
+	item, err = f.keeper.GetItem(ctx, broken.Id)
+	require.NoError(t, err)
+	require.False(t, item.Expired)
+}
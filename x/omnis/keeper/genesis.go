@@ -4,14 +4,142 @@ import (
 	"context"
 
 	"omnis/x/omnis/types"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
-	return k.Params.Set(ctx, genState.Params)
+	itemCollections := make(map[string]types.ItemCollection, len(genState.CollectionList))
+	for _, elem := range genState.CollectionList {
+		if err := k.ItemCollection.Set(ctx, elem.Namespace, elem); err != nil {
+			return err
+		}
+		// Limits are only enforced on creation, the imported items may exceed them
+		elem.MaxItems, elem.MaxItemsPerCreator = 0, 0
+		itemCollections[elem.Namespace] = elem
+	}
+
+	for _, elem := range genState.AttributeSchemaList {
+		if err := k.AttributeSchema.Set(ctx, elem.Namespace, elem); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range genState.LifecycleList {
+		if err := k.Lifecycles.Set(ctx, elem.Namespace, elem); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range genState.ItemList {
+		if err := k.SetItem(ctx, elem); err != nil {
+			return err
+		}
+		if elem.Alias != "" {
+			if err := k.setItemAlias(ctx, elem); err != nil {
+				return err
+			}
+		}
+		creator, err := k.addressCodec.StringToBytes(elem.Creator)
+		if err != nil {
+			return err
+		}
+		if err := k.addCollectionItem(ctx, itemCollections[elem.Namespace], sdk.AccAddress(creator)); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range genState.ItemRevisionList {
+		if err := k.ItemRevisions.Set(ctx, collections.Join(elem.ItemId, elem.Version), elem); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range genState.ProvenanceList {
+		if err := k.Provenance.Set(ctx, collections.Join(elem.ItemId, elem.Sequence), elem); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range genState.NotarizationList {
+		if err := k.Notarizations.Set(ctx, elem.ContentHash.IndexKey(), elem); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range genState.ItemApprovalList {
+		operator, err := k.addressCodec.StringToBytes(elem.Operator)
+		if err != nil {
+			return err
+		}
+		if err := k.ItemApprovals.Set(ctx, collections.Join(elem.ItemId, sdk.AccAddress(operator)), elem); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range genState.OperatorApprovalList {
+		owner, err := k.addressCodec.StringToBytes(elem.Owner)
+		if err != nil {
+			return err
+		}
+		operator, err := k.addressCodec.StringToBytes(elem.Operator)
+		if err != nil {
+			return err
+		}
+		if err := k.OperatorApprovals.Set(ctx, collections.Join(sdk.AccAddress(owner), sdk.AccAddress(operator)), elem); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range genState.ListingList {
+		if err := k.Listings.Set(ctx, elem.ItemId, elem); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range genState.ClassTraceList {
+		if err := k.ClassTraces.Set(ctx, elem.HashKey(), elem); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range genState.VoucherTokenList {
+		if err := k.VoucherTokens.Set(ctx, elem.ItemId, elem); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range genState.FractionList {
+		if err := k.Fractions.Set(ctx, elem.ItemId, elem); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range genState.AttestationList {
+		attester, err := k.addressCodec.StringToBytes(elem.Attester)
+		if err != nil {
+			return err
+		}
+		if err := k.Attestations.Set(ctx, collections.Join3(elem.ItemId, sdk.AccAddress(attester), elem.ClaimType), elem); err != nil {
+			return err
+		}
+	}
+
+	if err := k.ItemSeq.Set(ctx, genState.ItemCount); err != nil {
+		return err
+	}
+	if err := k.Params.Set(ctx, genState.Params); err != nil {
+		return err
+	}
+
+	// The x/nft genesis is imported first, only what it lacks is mirrored
+	return k.MirrorNFTs(ctx)
 }
 
-// ExportGenesis returns the module's exported genesis.
+// ExportGenesis returns the module's exported genesis. Items are exported in
+// ascending id order so that the output is deterministic.
 func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
 	var err error
 
@@ -21,5 +149,122 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	err = k.IterateItems(ctx, func(_ uint64, elem types.Item) (bool, error) {
+		genesis.ItemList = append(genesis.ItemList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.AttributeSchema.Walk(ctx, nil, func(_ string, elem types.AttributeSchema) (bool, error) {
+		genesis.AttributeSchemaList = append(genesis.AttributeSchemaList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Lifecycles.Walk(ctx, nil, func(_ string, elem types.ItemLifecycle) (bool, error) {
+		genesis.LifecycleList = append(genesis.LifecycleList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.ItemCollection.Walk(ctx, nil, func(_ string, elem types.ItemCollection) (bool, error) {
+		genesis.CollectionList = append(genesis.CollectionList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.ItemRevisions.Walk(ctx, nil, func(_ collections.Pair[uint64, uint64], elem types.ItemRevision) (bool, error) {
+		genesis.ItemRevisionList = append(genesis.ItemRevisionList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Provenance.Walk(ctx, nil, func(_ collections.Pair[uint64, uint64], elem types.CustodyRecord) (bool, error) {
+		genesis.ProvenanceList = append(genesis.ProvenanceList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Notarizations.Walk(ctx, nil, func(_ string, elem types.Notarization) (bool, error) {
+		genesis.NotarizationList = append(genesis.NotarizationList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.ItemApprovals.Walk(ctx, nil, func(_ collections.Pair[uint64, sdk.AccAddress], elem types.ItemApproval) (bool, error) {
+		genesis.ItemApprovalList = append(genesis.ItemApprovalList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.OperatorApprovals.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, sdk.AccAddress], elem types.OperatorApproval) (bool, error) {
+		genesis.OperatorApprovalList = append(genesis.OperatorApprovalList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Listings.Walk(ctx, nil, func(_ uint64, elem types.Listing) (bool, error) {
+		genesis.ListingList = append(genesis.ListingList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.ClassTraces.Walk(ctx, nil, func(_ string, elem types.ClassTrace) (bool, error) {
+		genesis.ClassTraceList = append(genesis.ClassTraceList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.VoucherTokens.Walk(ctx, nil, func(_ uint64, elem types.VoucherToken) (bool, error) {
+		genesis.VoucherTokenList = append(genesis.VoucherTokenList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Fractions.Walk(ctx, nil, func(_ uint64, elem types.Fraction) (bool, error) {
+		genesis.FractionList = append(genesis.FractionList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Attestations.Walk(ctx, nil, func(_ collections.Triple[uint64, sdk.AccAddress, string], elem types.Attestation) (bool, error) {
+		genesis.AttestationList = append(genesis.AttestationList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	genesis.ItemCount, err = k.ItemSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return genesis, nil
 }
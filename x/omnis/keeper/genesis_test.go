@@ -5,20 +5,51 @@ import (
 
 	"omnis/x/omnis/types"
 
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesis(t *testing.T) {
+	f := initFixture(t)
+
+	owner, err := f.addressCodec.BytesToString([]byte("ownerAddr___________________"))
+	require.NoError(t, err)
+	creator, err := f.addressCodec.BytesToString([]byte("creatorAddr_________________"))
+	require.NoError(t, err)
+
 	genesisState := types.GenesisState{
 		Params: types.DefaultParams(),
+		ItemList: []types.Item{
+			{Id: 0, Owner: owner, Creator: creator, Alias: "first", Namespace: "default"},
+			{Id: 1, Owner: creator, Creator: creator, Namespace: "default"},
+		},
+		ItemCount: 2,
+		CollectionList: []types.ItemCollection{
+			{Namespace: "default", Admin: creator, CreationPolicy: types.CREATION_POLICY_ADMIN_ONLY, MaxItems: 1},
+		},
 	}
 
-	f := initFixture(t)
-	err := f.keeper.InitGenesis(f.ctx, genesisState)
+	err = f.keeper.InitGenesis(f.ctx, genesisState)
 	require.NoError(t, err)
 	got, err := f.keeper.ExportGenesis(f.ctx)
 	require.NoError(t, err)
 	require.NotNil(t, got)
 
 	require.EqualExportedValues(t, genesisState.Params, got.Params)
+	require.EqualExportedValues(t, genesisState.ItemList, got.ItemList)
+	require.Equal(t, genesisState.ItemCount, got.ItemCount)
+	require.EqualExportedValues(t, genesisState.CollectionList, got.CollectionList)
+
+	// Aliases are rebuilt from the items
+	creatorAddr, err := f.addressCodec.StringToBytes(creator)
+	require.NoError(t, err)
+	id, err := f.keeper.ItemAlias.Get(f.ctx, collections.Join(sdk.AccAddress(creatorAddr), "first"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), id)
+
+	// Collection counts are rebuilt from the items, even above the limits
+	count, err := f.keeper.CollectionItemCount.Get(f.ctx, "default")
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), count)
 }
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+
+	"omnis/x/omnis/types"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// recordItemRevision bumps the version of item and appends the change from
+// prev to the item history, pruning the revisions beyond the retention
+// param. prev is the zero Item for a creation. It must be called before the
+// item is stored so that the new version is persisted.
+func (k Keeper) recordItemRevision(ctx context.Context, prev types.Item, item *types.Item, editor string) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	item.Version = prev.Version + 1
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	revision := types.ItemRevision{
+		ItemId:        item.Id,
+		Version:       item.Version,
+		Height:        sdkCtx.BlockHeight(),
+		Time:          sdkCtx.BlockTime(),
+		Editor:        editor,
+		ChangedFields: types.ItemChangedFields(prev, *item),
+		Item:          *item,
+	}
+	if err := k.ItemRevisions.Set(ctx, collections.Join(item.Id, item.Version), revision); err != nil {
+		return err
+	}
+
+	// Pruning every revision up to the bound, and not only the oldest one,
+	// also applies a lowered retention param.
+	if item.Version <= params.MaxItemRevisions {
+		return nil
+	}
+	return k.ItemRevisions.Clear(ctx,
+		collections.NewPrefixedPairRange[uint64, uint64](item.Id).EndInclusive(item.Version-params.MaxItemRevisions),
+	)
+}
@@ -0,0 +1,272 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"omnis/x/omnis/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+)
+
+// ics4Wrapper returns the channel keeper ICS-721 packets are sent with, or nil
+// when IBC is not wired.
+func (k Keeper) ics4Wrapper() types.ICS4Wrapper {
+	if k.ics4WrapperFn == nil {
+		return nil
+	}
+	return k.ics4WrapperFn()
+}
+
+// OnRecvPacket hands the items of an ICS-721 packet over to its receiver.
+// Items coming back to this chain are released from escrow, the others are
+// minted as vouchers in the collection of their class trace. The token data
+// of the packet is not kept.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+	if _, err := k.addressCodec.StringToBytes(data.Receiver); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address: %s", err)
+	}
+
+	var namespace string
+	if types.ReceiverChainIsSource(packet.SourcePort, packet.SourceChannel, data.ClassID) {
+		classID := data.ClassID[len(types.ClassPrefix(packet.SourcePort, packet.SourceChannel)):]
+		namespace = namespaceOfClass(classID)
+
+		escrow, err := k.addressCodec.BytesToString(types.GetEscrowAddress(packet.DestinationPort, packet.DestinationChannel))
+		if err != nil {
+			return err
+		}
+		for _, tokenID := range data.TokenIDs {
+			if err := k.unescrowItem(ctx, namespace, tokenID, escrow, data.Receiver, types.CUSTODY_REASON_IBC_RECEIVE); err != nil {
+				return err
+			}
+		}
+	} else {
+		trace := types.ParseClassTrace(types.ClassPrefix(packet.DestinationPort, packet.DestinationChannel) + data.ClassID)
+		if err := k.saveClassTrace(ctx, trace); err != nil {
+			return err
+		}
+		namespace = trace.IBCClassID()
+
+		for i, tokenID := range data.TokenIDs {
+			if err := k.mintVoucher(ctx, namespace, tokenID, data.TokenURI(i), data.Receiver); err != nil {
+				return err
+			}
+		}
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventItemsReceivedIBC{
+		Sender:             data.Sender,
+		Receiver:           data.Receiver,
+		DestinationChannel: packet.DestinationChannel,
+		ClassId:            data.ClassID,
+		TokenIds:           data.TokenIDs,
+		Namespace:          namespace,
+	})
+}
+
+// OnAcknowledgementPacket refunds the items of an ICS-721 packet the
+// destination chain failed to receive.
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData, ack channeltypes.Acknowledgement) error {
+	if ack.Success() {
+		return nil
+	}
+	return k.refundPacketItems(ctx, packet, data, ack.GetError())
+}
+
+// OnTimeoutPacket refunds the items of an ICS-721 packet which timed out.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+	return k.refundPacketItems(ctx, packet, data, "")
+}
+
+// refundPacketItems returns the items of a packet sent from this chain to
+// their sender, releasing them from escrow or minting back their vouchers.
+func (k Keeper) refundPacketItems(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData, reason string) error {
+	namespace := namespaceOfClass(data.ClassID)
+	if types.ReceiverChainIsSource(packet.SourcePort, packet.SourceChannel, data.ClassID) {
+		for i, tokenID := range data.TokenIDs {
+			if err := k.mintVoucher(ctx, namespace, tokenID, data.TokenURI(i), data.Sender); err != nil {
+				return err
+			}
+		}
+	} else {
+		escrow, err := k.addressCodec.BytesToString(types.GetEscrowAddress(packet.SourcePort, packet.SourceChannel))
+		if err != nil {
+			return err
+		}
+		for _, tokenID := range data.TokenIDs {
+			if err := k.unescrowItem(ctx, namespace, tokenID, escrow, data.Sender, types.CUSTODY_REASON_IBC_REFUND); err != nil {
+				return err
+			}
+		}
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventItemsRefundedIBC{
+		Sender:        data.Sender,
+		SourceChannel: packet.SourceChannel,
+		Sequence:      packet.Sequence,
+		ClassId:       data.ClassID,
+		TokenIds:      data.TokenIDs,
+		Reason:        reason,
+	})
+}
+
+// namespaceOfClass returns the namespace of the collection mirroring an
+// ICS-721 class on this chain: the namespace itself for the collections
+// originating from this chain, or the voucher collection of the class trace.
+func namespaceOfClass(classID string) string {
+	trace := types.ParseClassTrace(classID)
+	if trace.Path == "" {
+		return trace.BaseClassId
+	}
+	return trace.IBCClassID()
+}
+
+// classIDOf returns the id of the ICS-721 class mirroring a collection.
+func (k Keeper) classIDOf(ctx context.Context, namespace string) (string, error) {
+	if !types.IsVoucherNamespace(namespace) {
+		return namespace, nil
+	}
+	trace, err := k.ClassTraces.Get(ctx, namespace[len(types.VoucherPrefix):])
+	if err != nil {
+		return "", errorsmod.Wrapf(sdkerrors.ErrLogic, "failed to get class trace of %s", namespace)
+	}
+	return trace.GetFullClassPath(), nil
+}
+
+// tokenIDOf returns the id of the ICS-721 token mirroring an item.
+func (k Keeper) tokenIDOf(ctx context.Context, item types.Item) (string, error) {
+	if !types.IsVoucherNamespace(item.Namespace) {
+		return types.NFTID(item.Id), nil
+	}
+	token, err := k.VoucherTokens.Get(ctx, item.Id)
+	if err != nil {
+		return "", errorsmod.Wrapf(sdkerrors.ErrLogic, "failed to get token id of item %d", item.Id)
+	}
+	return token.TokenId, nil
+}
+
+// itemIDOf returns the id of the item mirroring an ICS-721 token, or
+// ErrKeyNotFound.
+func (k Keeper) itemIDOf(ctx context.Context, namespace, tokenID string) (uint64, error) {
+	if !types.IsVoucherNamespace(namespace) {
+		id, err := strconv.ParseUint(tokenID, 10, 64)
+		if err != nil || types.NFTID(id) != tokenID {
+			return 0, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "no item for token %s of %s", tokenID, namespace)
+		}
+		return id, nil
+	}
+	id, err := k.VoucherTokens.Indexes.Token.MatchExact(ctx, collections.Join(namespace, tokenID))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return 0, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "no item for token %s of %s", tokenID, namespace)
+		}
+		return 0, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get voucher token")
+	}
+	return id, nil
+}
+
+// escrowItem hands an item sent over a channel over to the escrow address of
+// the channel.
+func (k Keeper) escrowItem(ctx context.Context, item types.Item, escrow string) error {
+	return k.transferItem(ctx, item, escrow, item.Owner, types.CUSTODY_REASON_IBC_SEND, "")
+}
+
+// unescrowItem hands an item escrowed by a channel over to receiver.
+func (k Keeper) unescrowItem(ctx context.Context, namespace, tokenID, escrow, receiver string, reason types.CustodyReason) error {
+	id, err := k.itemIDOf(ctx, namespace, tokenID)
+	if err != nil {
+		return err
+	}
+	item, err := k.getOwnedItem(ctx, id, escrow)
+	if err != nil {
+		return err
+	}
+	if item.Namespace != namespace {
+		return errorsmod.Wrapf(types.ErrInvalidPacket, "item %d is not in collection %s", item.Id, namespace)
+	}
+	return k.transferItem(ctx, item, receiver, escrow, reason, "")
+}
+
+// mintVoucher creates the item mirroring a token received over ICS-721 in
+// its voucher collection.
+func (k Keeper) mintVoucher(ctx context.Context, namespace, tokenID, uri, receiver string) error {
+	if err := types.ValidateItemURI(uri); err != nil {
+		return err
+	}
+	creator, err := k.addressCodec.BytesToString(authtypes.NewModuleAddress(types.ModuleName))
+	if err != nil {
+		return err
+	}
+
+	// A token cannot be received twice
+	if _, err := k.itemIDOf(ctx, namespace, tokenID); err == nil {
+		return errorsmod.Wrapf(types.ErrInvalidPacket, "token %s of %s already received", tokenID, namespace)
+	} else if !errors.Is(err, sdkerrors.ErrKeyNotFound) {
+		return err
+	}
+
+	id, err := k.createItem(ctx, types.Item{
+		Owner:     receiver,
+		Creator:   creator,
+		Namespace: namespace,
+		Uri:       uri,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := k.VoucherTokens.Set(ctx, id, types.VoucherToken{ItemId: id, Namespace: namespace, TokenId: tokenID}); err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidPacket, "failed to set voucher token %s of %s: %s", tokenID, namespace, err)
+	}
+	return nil
+}
+
+// burnVoucher deletes an item received over ICS-721, when it heads back to
+// the chain it came from.
+func (k Keeper) burnVoucher(ctx context.Context, item types.Item) error {
+	return k.removeItem(ctx, item, types.CUSTODY_REASON_IBC_SEND)
+}
+
+// saveClassTrace records the trace of a class received over ICS-721 and
+// creates its voucher collection, unless they exist. Voucher collections are
+// administered by the module, so that only ICS-721 packets create their
+// items.
+func (k Keeper) saveClassTrace(ctx context.Context, trace types.ClassTrace) error {
+	if err := trace.Validate(); err != nil {
+		return err
+	}
+	found, err := k.ClassTraces.Has(ctx, trace.HashKey())
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get class trace")
+	}
+	if found {
+		return nil
+	}
+	if err := k.ClassTraces.Set(ctx, trace.HashKey(), trace); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set class trace")
+	}
+
+	admin, err := k.addressCodec.BytesToString(authtypes.NewModuleAddress(types.ModuleName))
+	if err != nil {
+		return err
+	}
+	collection := types.ItemCollection{
+		Namespace:      trace.IBCClassID(),
+		Admin:          admin,
+		CreationPolicy: types.CREATION_POLICY_ADMIN_ONLY,
+	}
+	if err := k.ItemCollection.Set(ctx, collection.Namespace, collection); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set collection")
+	}
+	if err := k.saveCollectionClass(ctx, collection); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to save collection nft class")
+	}
+	return nil
+}
@@ -0,0 +1,195 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"omnis/x/omnis/keeper"
+	"omnis/x/omnis/types"
+)
+
+func TestIBCTransferItems(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	owner, err := f.addressCodec.BytesToString([]byte("ownerAddr___________________"))
+	require.NoError(t, err)
+	other, err := f.addressCodec.BytesToString([]byte("otherAddr___________________"))
+	require.NoError(t, err)
+	escrow, err := f.addressCodec.BytesToString(types.GetEscrowAddress(types.PortID, "channel-0"))
+	require.NoError(t, err)
+
+	namespace := createOpenCollection(t, f, owner)
+	resp, err := srv.CreateItem(ctx, &types.MsgCreateItem{Creator: owner, Namespace: namespace})
+	require.NoError(t, err)
+
+	transfer := func(creator string, ids ...uint64) *types.MsgIBCTransferItems {
+		return types.NewMsgIBCTransferItems(creator, types.PortID, "channel-0", namespace, ids, "partner1receiver", 1, "")
+	}
+	tests := []struct {
+		desc    string
+		request *types.MsgIBCTransferItems
+		err     error
+	}{
+		{
+			desc:    "no items",
+			request: transfer(owner),
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "not the owner",
+			request: transfer(other, resp.Id),
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "duplicated item",
+			request: transfer(owner, resp.Id, resp.Id),
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "completed",
+			request: transfer(owner, resp.Id),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.IBCTransferItems(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	// The item is escrowed and described by the packet
+	requireOwner := func(id uint64, expected string) {
+		t.Helper()
+		item, err := f.keeper.GetItem(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, expected, item.Owner)
+	}
+	requireOwner(resp.Id, escrow)
+	require.Len(t, f.ics4Wrapper.packets, 1)
+	packet := f.ics4Wrapper.packets[0]
+	data, err := types.UnmarshalPacketData(packet.Data)
+	require.NoError(t, err)
+	require.Equal(t, namespace, data.ClassID)
+	require.Equal(t, []string{types.NFTID(resp.Id)}, data.TokenIDs)
+	require.Equal(t, owner, data.Sender)
+
+	// A timeout refunds the sender
+	require.NoError(t, f.keeper.OnTimeoutPacket(ctx, packet, data))
+	requireOwner(resp.Id, owner)
+
+	// A successful acknowledgement keeps the item escrowed
+	_, err = srv.IBCTransferItems(ctx, transfer(owner, resp.Id))
+	require.NoError(t, err)
+	packet = f.ics4Wrapper.packets[1]
+	require.NoError(t, f.keeper.OnAcknowledgementPacket(ctx, packet, data, channeltypes.NewResultAcknowledgement([]byte{1})))
+	requireOwner(resp.Id, escrow)
+
+	// The item is released from escrow when it comes back
+	require.NoError(t, f.keeper.OnRecvPacket(ctx, channeltypes.Packet{
+		SourcePort:         types.PortID,
+		SourceChannel:      "channel-7",
+		DestinationPort:    types.PortID,
+		DestinationChannel: "channel-0",
+	}, types.NewNonFungibleTokenPacketData(
+		types.ClassPrefix(types.PortID, "channel-7")+namespace, data.TokenIDs, nil, "partner1receiver", other, "",
+	)))
+	requireOwner(resp.Id, other)
+
+	// An error acknowledgement refunds the sender
+	_, err = srv.IBCTransferItems(ctx, transfer(other, resp.Id))
+	require.NoError(t, err)
+	packet = f.ics4Wrapper.packets[2]
+	data, err = types.UnmarshalPacketData(packet.Data)
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.OnAcknowledgementPacket(ctx, packet, data, channeltypes.NewErrorAcknowledgement(errors.New("failed"))))
+	requireOwner(resp.Id, other)
+}
+
+func TestIBCReceiveVouchers(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	receiver, err := f.addressCodec.BytesToString([]byte("receiverAddr________________"))
+	require.NoError(t, err)
+
+	packet := channeltypes.Packet{
+		SourcePort:         types.PortID,
+		SourceChannel:      "channel-7",
+		DestinationPort:    types.PortID,
+		DestinationChannel: "channel-0",
+	}
+	data := types.NewNonFungibleTokenPacketData("kitties", []string{"a", "b"}, []string{"ipfs://a", ""}, "partner1sender", receiver, "")
+	require.NoError(t, f.keeper.OnRecvPacket(ctx, packet, data))
+
+	// The vouchers are minted in the collection of the class trace
+	trace := types.ClassTrace{Path: "nft-transfer/channel-0", BaseClassId: "kitties"}
+	require.Equal(t, trace, types.ParseClassTrace("nft-transfer/channel-0/kitties"))
+	got, err := qs.ClassTrace(ctx, &types.QueryClassTraceRequest{Hash: trace.IBCClassID()})
+	require.NoError(t, err)
+	require.Equal(t, trace, got.ClassTrace)
+	traces, err := qs.ClassTraces(ctx, &types.QueryClassTracesRequest{})
+	require.NoError(t, err)
+	require.Len(t, traces.ClassTraces, 1)
+
+	items, err := qs.ItemsByOwner(ctx, &types.QueryItemsByOwnerRequest{Owner: receiver})
+	require.NoError(t, err)
+	require.Len(t, items.Items, 2)
+	voucher := items.Items[0]
+	require.Equal(t, trace.IBCClassID(), voucher.Namespace)
+	require.Equal(t, "ipfs://a", voucher.Uri)
+
+	// A token cannot be received twice
+	err = f.keeper.OnRecvPacket(ctx, packet, types.NewNonFungibleTokenPacketData("kitties", []string{"a"}, nil, "partner1sender", receiver, ""))
+	require.ErrorIs(t, err, types.ErrInvalidPacket)
+
+	// Nobody may create items in a voucher collection
+	_, err = srv.CreateItem(ctx, &types.MsgCreateItem{Creator: receiver, Namespace: trace.IBCClassID()})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.CreateCollection(ctx, &types.MsgCreateCollection{
+		Creator:        receiver,
+		Namespace:      types.ClassTrace{Path: "nft-transfer/channel-1", BaseClassId: "kitties"}.IBCClassID(),
+		CreationPolicy: types.CREATION_POLICY_OPEN,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidNamespace)
+
+	// Vouchers heading back to their origin are burnt
+	_, err = srv.IBCTransferItems(ctx, types.NewMsgIBCTransferItems(receiver, types.PortID, "channel-0", trace.IBCClassID(), []uint64{voucher.Id}, "partner1sender", 1, ""))
+	require.NoError(t, err)
+	found, err := f.keeper.HasItem(ctx, voucher.Id)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	sent, err := types.UnmarshalPacketData(f.ics4Wrapper.packets[0].Data)
+	require.NoError(t, err)
+	require.Equal(t, "nft-transfer/channel-0/kitties", sent.ClassID)
+	require.Equal(t, []string{"a"}, sent.TokenIDs)
+	require.Equal(t, []string{"ipfs://a"}, sent.TokenURIs)
+
+	// and minted back on timeout
+	require.NoError(t, f.keeper.OnTimeoutPacket(ctx, f.ics4Wrapper.packets[0], sent))
+	items, err = qs.ItemsByOwner(ctx, &types.QueryItemsByOwnerRequest{Owner: receiver})
+	require.NoError(t, err)
+	require.Len(t, items.Items, 2)
+	// Deleting a voucher forgets its token
+	refunded := items.Items[1]
+	require.Equal(t, "ipfs://a", refunded.Uri)
+	_, err = srv.DeleteItem(ctx, &types.MsgDeleteItem{Creator: receiver, Id: refunded.Id})
+	require.NoError(t, err)
+	_, err = f.keeper.VoucherTokens.Get(ctx, refunded.Id)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	require.NoError(t, f.keeper.OnRecvPacket(ctx, packet, types.NewNonFungibleTokenPacketData("kitties", []string{"a"}, nil, "partner1sender", receiver, "")))
+}
@@ -1,12 +1,18 @@
 package keeper
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
 	"cosmossdk.io/core/address"
 	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"omnis/x/omnis/types"
 )
@@ -19,41 +25,187 @@ type Keeper struct {
 	// Typically, this should be the x/gov module account.
 	authority []byte
 
-	Schema collections.Schema
-	Params collections.Item[types.Params]
-	 Items collections.Map[string, types.Item] 
+	bankKeeper types.BankKeeper
+	// nftKeeper is optional. When set, collections and items are mirrored as
+	// x/nft classes and NFTs.
+	nftKeeper types.NFTKeeper
+	// ics4WrapperFn returns the channel keeper ICS-721 packets are sent with.
+	// It is a getter since the IBC keeper is created after the module, and is
+	// nil when IBC is not wired.
+	ics4WrapperFn func() types.ICS4Wrapper
+	// tokenKeeper is optional. When set, items can be fractionalized into
+	// tokens of the x/token registry.
+	tokenKeeper types.TokenKeeper
 
-// Inside type Keeper struct { ... }
+	Schema    collections.Schema
+	Params    collections.Item[types.Params]
+	Items     *collections.IndexedMap[uint64, types.Item, ItemIndexes]
+	ItemSeq   collections.Sequence
+	ItemAlias collections.Map[collections.Pair[sdk.AccAddress, string], uint64]
+	// ItemRevisions is the version log of items, keyed by item id and version.
+	ItemRevisions collections.Map[collections.Pair[uint64, uint64], types.ItemRevision]
+	// Provenance is the append-only chain of custody of items, keyed by item
+	// id and sequence.
+	Provenance collections.Map[collections.Pair[uint64, uint64], types.CustodyRecord]
 
-// SetItem stores an item in the KVStore.
-func (k Keeper) SetItem(ctx context.Context, item types.Item) error {
-    return k.Items.Set(ctx, item.Id, item) // Assuming item.Id is your string key
+	// ItemApprovals holds the operators approved for a single item.
+	ItemApprovals collections.Map[collections.Pair[uint64, sdk.AccAddress], types.ItemApproval]
+	// OperatorApprovals holds the operators approved for all the items of an
+	// owner, keyed by owner and operator.
+	OperatorApprovals collections.Map[collections.Pair[sdk.AccAddress, sdk.AccAddress], types.OperatorApproval]
+
+	AttributeSchema collections.Map[string, types.AttributeSchema]
+	// Lifecycles holds the lifecycles of collections, keyed by namespace.
+	Lifecycles collections.Map[string, types.ItemLifecycle]
+	// Notarizations holds the first registration of each content hash, keyed
+	// by types.ContentHash.IndexKey.
+	Notarizations collections.Map[string, types.Notarization]
+
+	ItemCollection         collections.Map[string, types.ItemCollection]
+	CollectionItemCount    collections.Map[string, uint64]
+	CollectionCreatorCount collections.Map[collections.Pair[string, sdk.AccAddress], uint64]
+
+	// Listings holds the marketplace listings, keyed by item id.
+	Listings *collections.IndexedMap[uint64, types.Listing, ListingIndexes]
+
+	// ClassTraces holds the traces of the ICS-721 classes received, keyed by
+	// types.ClassTrace.HashKey.
+	ClassTraces collections.Map[string, types.ClassTrace]
+	// VoucherTokens holds the token ids of the items received over ICS-721,
+	// keyed by item id.
+	VoucherTokens *collections.IndexedMap[uint64, types.VoucherToken, VoucherTokenIndexes]
+
+	// Fractions holds the fractionalized items, keyed by item id.
+	Fractions collections.Map[uint64, types.Fraction]
+
+	// Attestations holds the attestations of items, keyed by item, attester
+	// and claim type.
+	Attestations *collections.IndexedMap[collections.Triple[uint64, sdk.AccAddress, string], types.Attestation, AttestationIndexes]
+
+	// itemsByOwner is a read-only view over the owner index of Items, used to
+	// paginate over the items of a single owner.
+	itemsByOwner collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
+	// listingsByCollection and listingsBySeller are read-only views over the
+	// indexes of Listings, used to paginate over the listings of a collection
+	// or of a seller.
+	listingsByCollection collections.KeySet[collections.Pair[string, uint64]]
+	listingsBySeller     collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
+	// attestationsByAttester is a read-only view over the attester index of
+	// Attestations, used to paginate over the attestations of an attester.
+	attestationsByAttester collections.KeySet[collections.Pair[sdk.AccAddress, collections.Triple[uint64, sdk.AccAddress, string]]]
+	// itemsByAttribute indexes items by types.AttributeIndexKey. It is kept
+	// up to date by SetItem and DeleteItem.
+	itemsByAttribute collections.KeySet[collections.Pair[string, uint64]]
+	// itemsByContentHash indexes notarized items by types.ContentHash.IndexKey.
+	// It is kept up to date by SetItem and DeleteItem.
+	itemsByContentHash collections.KeySet[collections.Pair[string, uint64]]
+	// itemsByState indexes items by types.StateIndexKey. It is kept up to
+	// date by SetItem and DeleteItem.
+	itemsByState collections.KeySet[collections.Pair[string, uint64]]
+	// expiryQueue orders the items that have yet to expire by expiry time. It
+	// is kept up to date by SetItem and DeleteItem.
+	expiryQueue collections.KeySet[collections.Pair[time.Time, uint64]]
+	// userExpiryQueue orders the rented items by the end of their rental. It
+	// is kept up to date by SetItem and DeleteItem.
+	userExpiryQueue collections.KeySet[collections.Pair[time.Time, uint64]]
+}
+
+// ItemIndexes defines the secondary indexes of the Items map.
+type ItemIndexes struct {
+	// Owner indexes items by the address of their owner.
+	Owner *indexes.Multi[sdk.AccAddress, uint64, types.Item]
 }
 
-// GetItem retrieves an item from the KVStore.
-func (k Keeper) GetItem(ctx context.Context, id string) (types.Item, error) {
-    item, err := k.Items.Get(ctx, id)
-    if err != nil {
-        return types.Item{}, err // Handle collections.ErrNotFound in calling code
-    }
-    return item, nil
+func (i ItemIndexes) IndexesList() []collections.Index[uint64, types.Item] {
+	return []collections.Index[uint64, types.Item]{i.Owner}
 }
 
-// HasItem checks if an item exists in the KVStore.
-func (k Keeper) HasItem(ctx context.Context, id string) (bool, error) {
-    return k.Items.Has(ctx, id)
+func NewItemIndexes(sb *collections.SchemaBuilder, addressCodec address.Codec) ItemIndexes {
+	return ItemIndexes{
+		Owner: indexes.NewMulti(
+			sb, types.ItemOwnerIndexPrefix, "items_by_owner",
+			sdk.AccAddressKey, collections.Uint64Key,
+			func(_ uint64, item types.Item) (sdk.AccAddress, error) {
+				return addressCodec.StringToBytes(item.Owner)
+			},
+		),
+	}
 }
 
-// DeleteItem deletes an item from the KVStore.
-func (k Keeper) DeleteItem(ctx context.Context, id string) error {
-    return k.Items.Remove(ctx, id)
+// ListingIndexes defines the secondary indexes of the Listings map.
+type ListingIndexes struct {
+	// Collection indexes listings by the namespace of the collection of the item.
+	Collection *indexes.Multi[string, uint64, types.Listing]
+	// Seller indexes listings by the address of their seller.
+	Seller *indexes.Multi[sdk.AccAddress, uint64, types.Listing]
 }
 
-// IterateItems iterates over all items.
-func (k Keeper) IterateItems(ctx context.Context, cb func(id string, item types.Item) (bool, error)) error {
-    return k.Items.Walk(ctx, nil, cb)
+func (i ListingIndexes) IndexesList() []collections.Index[uint64, types.Listing] {
+	return []collections.Index[uint64, types.Listing]{i.Collection, i.Seller}
+}
+
+func NewListingIndexes(sb *collections.SchemaBuilder, addressCodec address.Codec) ListingIndexes {
+	return ListingIndexes{
+		Collection: indexes.NewMulti(
+			sb, types.ListingCollectionIndexPrefix, "listings_by_collection",
+			collections.StringKey, collections.Uint64Key,
+			func(_ uint64, listing types.Listing) (string, error) {
+				return listing.Namespace, nil
+			},
+		),
+		Seller: indexes.NewMulti(
+			sb, types.ListingSellerIndexPrefix, "listings_by_seller",
+			sdk.AccAddressKey, collections.Uint64Key,
+			func(_ uint64, listing types.Listing) (sdk.AccAddress, error) {
+				return addressCodec.StringToBytes(listing.Seller)
+			},
+		),
+	}
+}
+
+// VoucherTokenIndexes defines the secondary indexes of the VoucherTokens map.
+type VoucherTokenIndexes struct {
+	// Token indexes the items received over ICS-721 by voucher collection and
+	// token id.
+	Token *indexes.Unique[collections.Pair[string, string], uint64, types.VoucherToken]
+}
+
+func (i VoucherTokenIndexes) IndexesList() []collections.Index[uint64, types.VoucherToken] {
+	return []collections.Index[uint64, types.VoucherToken]{i.Token}
 }
 
+func NewVoucherTokenIndexes(sb *collections.SchemaBuilder) VoucherTokenIndexes {
+	return VoucherTokenIndexes{
+		Token: indexes.NewUnique(
+			sb, types.VoucherTokenIndexPrefix, "voucher_tokens_by_token",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.Uint64Key,
+			func(_ uint64, token types.VoucherToken) (collections.Pair[string, string], error) {
+				return collections.Join(token.Namespace, token.TokenId), nil
+			},
+		),
+	}
+}
+
+// AttestationIndexes defines the secondary indexes of the Attestations map.
+type AttestationIndexes struct {
+	// Attester indexes attestations by the address of their attester.
+	Attester *indexes.Multi[sdk.AccAddress, collections.Triple[uint64, sdk.AccAddress, string], types.Attestation]
+}
+
+func (i AttestationIndexes) IndexesList() []collections.Index[collections.Triple[uint64, sdk.AccAddress, string], types.Attestation] {
+	return []collections.Index[collections.Triple[uint64, sdk.AccAddress, string], types.Attestation]{i.Attester}
+}
+
+func NewAttestationIndexes(sb *collections.SchemaBuilder) AttestationIndexes {
+	return AttestationIndexes{
+		Attester: indexes.NewMulti(
+			sb, types.AttestationAttesterIndexPrefix, "attestations_by_attester",
+			sdk.AccAddressKey, collections.TripleKeyCodec(collections.Uint64Key, sdk.AccAddressKey, collections.StringKey),
+			func(key collections.Triple[uint64, sdk.AccAddress, string], _ types.Attestation) (sdk.AccAddress, error) {
+				return key.K2(), nil
+			},
+		),
+	}
 }
 
 func NewKeeper(
@@ -61,7 +213,10 @@ func NewKeeper(
 	cdc codec.Codec,
 	addressCodec address.Codec,
 	authority []byte,
-
+	bankKeeper types.BankKeeper,
+	nftKeeper types.NFTKeeper,
+	ics4WrapperFn func() types.ICS4Wrapper,
+	tokenKeeper types.TokenKeeper,
 ) Keeper {
 	if _, err := addressCodec.BytesToString(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address %s: %s", authority, err))
@@ -74,17 +229,103 @@ func NewKeeper(
 		cdc:          cdc,
 		addressCodec: addressCodec,
 		authority:    authority,
+		bankKeeper:   bankKeeper,
+		nftKeeper:    nftKeeper,
+
+		ics4WrapperFn: ics4WrapperFn,
+		tokenKeeper:   tokenKeeper,
 
 		Params: collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-	 Items: collections.NewMap(
-            sb,
-            types.ItemKeyPrefix, // Unique prefix for this collection
-            "items",             // Human-readable name for the collection
-            collections.StringKey, // Key type (e.g., string, uint64, sdk.AccAddress)
-            codec.CollValue[types.Item](cdc), // Value type (your protobuf message)
-        ),
-	
-	
+		Items: collections.NewIndexedMap(
+			sb, types.ItemKeyPrefix, "items",
+			collections.Uint64Key, codec.CollValue[types.Item](cdc),
+			NewItemIndexes(sb, addressCodec),
+		),
+		ItemSeq: collections.NewSequence(sb, types.ItemCountKey, "itemSequence"),
+		ItemAlias: collections.NewMap(
+			sb, types.ItemAliasKeyPrefix, "item_alias",
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), collections.Uint64Value,
+		),
+		ItemRevisions: collections.NewMap(
+			sb, types.ItemRevisionKeyPrefix, "item_revisions",
+			collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key), codec.CollValue[types.ItemRevision](cdc),
+		),
+		Provenance: collections.NewMap(
+			sb, types.ProvenanceKeyPrefix, "provenance",
+			collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key), codec.CollValue[types.CustodyRecord](cdc),
+		),
+		ItemApprovals: collections.NewMap(
+			sb, types.ItemApprovalKeyPrefix, "item_approvals",
+			collections.PairKeyCodec(collections.Uint64Key, sdk.AccAddressKey), codec.CollValue[types.ItemApproval](cdc),
+		),
+		OperatorApprovals: collections.NewMap(
+			sb, types.OperatorApprovalKeyPrefix, "operator_approvals",
+			collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey), codec.CollValue[types.OperatorApproval](cdc),
+		),
+		AttributeSchema:     collections.NewMap(sb, types.AttributeSchemaKeyPrefix, "attribute_schema", collections.StringKey, codec.CollValue[types.AttributeSchema](cdc)),
+		Notarizations:       collections.NewMap(sb, types.NotarizationKeyPrefix, "notarizations", collections.StringKey, codec.CollValue[types.Notarization](cdc)),
+		Lifecycles:          collections.NewMap(sb, types.LifecycleKeyPrefix, "lifecycles", collections.StringKey, codec.CollValue[types.ItemLifecycle](cdc)),
+		ItemCollection:      collections.NewMap(sb, types.CollectionKeyPrefix, "item_collection", collections.StringKey, codec.CollValue[types.ItemCollection](cdc)),
+		CollectionItemCount: collections.NewMap(sb, types.CollectionItemCountKeyPrefix, "collection_item_count", collections.StringKey, collections.Uint64Value),
+		CollectionCreatorCount: collections.NewMap(
+			sb, types.CollectionCreatorCountKeyPrefix, "collection_creator_count",
+			collections.PairKeyCodec(collections.StringKey, sdk.AccAddressKey), collections.Uint64Value,
+		),
+		Listings: collections.NewIndexedMap(
+			sb, types.ListingKeyPrefix, "listings",
+			collections.Uint64Key, codec.CollValue[types.Listing](cdc),
+			NewListingIndexes(sb, addressCodec),
+		),
+		ClassTraces: collections.NewMap(sb, types.ClassTraceKeyPrefix, "class_traces", collections.StringKey, codec.CollValue[types.ClassTrace](cdc)),
+		VoucherTokens: collections.NewIndexedMap(
+			sb, types.VoucherTokenKeyPrefix, "voucher_tokens",
+			collections.Uint64Key, codec.CollValue[types.VoucherToken](cdc),
+			NewVoucherTokenIndexes(sb),
+		),
+		Fractions: collections.NewMap(sb, types.FractionKeyPrefix, "fractions", collections.Uint64Key, codec.CollValue[types.Fraction](cdc)),
+		Attestations: collections.NewIndexedMap(
+			sb, types.AttestationKeyPrefix, "attestations",
+			collections.TripleKeyCodec(collections.Uint64Key, sdk.AccAddressKey, collections.StringKey), codec.CollValue[types.Attestation](cdc),
+			NewAttestationIndexes(sb),
+		),
+		itemsByAttribute: collections.NewKeySet(
+			sb, types.ItemAttributeIndexPrefix, "items_by_attribute",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+		),
+		itemsByContentHash: collections.NewKeySet(
+			sb, types.ItemContentHashIndexPrefix, "items_by_content_hash",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+		),
+		itemsByState: collections.NewKeySet(
+			sb, types.ItemStateIndexPrefix, "items_by_state",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+		),
+		expiryQueue: collections.NewKeySet(
+			sb, types.ItemExpiryQueuePrefix, "item_expiry_queue",
+			collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key),
+		),
+		userExpiryQueue: collections.NewKeySet(
+			sb, types.ItemUserExpiryQueuePrefix, "item_user_expiry_queue",
+			collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key),
+		),
+		// The view is built on its own schema builder since the owner index
+		// already registered the prefix in the module schema.
+		itemsByOwner: collections.NewKeySet(
+			collections.NewSchemaBuilder(storeService), types.ItemOwnerIndexPrefix, "items_by_owner",
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key),
+		),
+		listingsByCollection: collections.NewKeySet(
+			collections.NewSchemaBuilder(storeService), types.ListingCollectionIndexPrefix, "listings_by_collection",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+		),
+		listingsBySeller: collections.NewKeySet(
+			collections.NewSchemaBuilder(storeService), types.ListingSellerIndexPrefix, "listings_by_seller",
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key),
+		),
+		attestationsByAttester: collections.NewKeySet(
+			collections.NewSchemaBuilder(storeService), types.AttestationAttesterIndexPrefix, "attestations_by_attester",
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.TripleKeyCodec(collections.Uint64Key, sdk.AccAddressKey, collections.StringKey)),
+		),
 	}
 
 	schema, err := sb.Build()
@@ -100,3 +341,136 @@ func NewKeeper(
 func (k Keeper) GetAuthority() []byte {
 	return k.authority
 }
+
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return sdkCtx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// SetItem stores an item and updates its attribute, content hash and state
+// indexes and the expiry queues.
+func (k Keeper) SetItem(ctx context.Context, item types.Item) error {
+	old, err := k.Items.Get(ctx, item.Id)
+	switch {
+	case err == nil:
+		if err := k.unindexItem(ctx, old); err != nil {
+			return err
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+
+	if err := k.Items.Set(ctx, item.Id, item); err != nil {
+		return err
+	}
+	return k.indexItem(ctx, item)
+}
+
+// GetItem returns an item, or collections.ErrNotFound if it does not exist.
+func (k Keeper) GetItem(ctx context.Context, id uint64) (types.Item, error) {
+	return k.Items.Get(ctx, id)
+}
+
+// HasItem checks if an item exists.
+func (k Keeper) HasItem(ctx context.Context, id uint64) (bool, error) {
+	return k.Items.Has(ctx, id)
+}
+
+// DeleteItem removes an item from the store, its indexes and the expiry queues.
+func (k Keeper) DeleteItem(ctx context.Context, id uint64) error {
+	item, err := k.Items.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := k.unindexItem(ctx, item); err != nil {
+		return err
+	}
+	return k.Items.Remove(ctx, id)
+}
+
+// IterateItems iterates over all items.
+func (k Keeper) IterateItems(ctx context.Context, cb func(id uint64, item types.Item) (bool, error)) error {
+	return k.Items.Walk(ctx, nil, cb)
+}
+
+// GetItemLifecycle returns the lifecycle of a collection, or nil if the
+// collection has none.
+func (k Keeper) GetItemLifecycle(ctx context.Context, namespace string) (*types.ItemLifecycle, error) {
+	lifecycle, err := k.Lifecycles.Get(ctx, namespace)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &lifecycle, nil
+}
+
+// GetAttributeSchema returns the attribute schema of a collection, or nil if
+// the collection has none.
+func (k Keeper) GetAttributeSchema(ctx context.Context, namespace string) (*types.AttributeSchema, error) {
+	schema, err := k.AttributeSchema.Get(ctx, namespace)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &schema, nil
+}
+
+func (k Keeper) indexItem(ctx context.Context, item types.Item) error {
+	for _, attr := range item.Attributes {
+		key := collections.Join(types.AttributeIndexKey(item.Namespace, attr.Key, attr.Value), item.Id)
+		if err := k.itemsByAttribute.Set(ctx, key); err != nil {
+			return err
+		}
+	}
+	if item.ContentHash != nil {
+		if err := k.itemsByContentHash.Set(ctx, collections.Join(item.ContentHash.IndexKey(), item.Id)); err != nil {
+			return err
+		}
+	}
+	if item.State != "" {
+		if err := k.itemsByState.Set(ctx, collections.Join(types.StateIndexKey(item.Namespace, item.State), item.Id)); err != nil {
+			return err
+		}
+	}
+	if item.ExpiresAt != nil && !item.Expired {
+		if err := k.expiryQueue.Set(ctx, collections.Join(*item.ExpiresAt, item.Id)); err != nil {
+			return err
+		}
+	}
+	if item.User != "" && item.UserExpiresAt != nil {
+		return k.userExpiryQueue.Set(ctx, collections.Join(*item.UserExpiresAt, item.Id))
+	}
+	return nil
+}
+
+func (k Keeper) unindexItem(ctx context.Context, item types.Item) error {
+	for _, attr := range item.Attributes {
+		key := collections.Join(types.AttributeIndexKey(item.Namespace, attr.Key, attr.Value), item.Id)
+		if err := k.itemsByAttribute.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+	if item.ContentHash != nil {
+		if err := k.itemsByContentHash.Remove(ctx, collections.Join(item.ContentHash.IndexKey(), item.Id)); err != nil {
+			return err
+		}
+	}
+	if item.State != "" {
+		if err := k.itemsByState.Remove(ctx, collections.Join(types.StateIndexKey(item.Namespace, item.State), item.Id)); err != nil {
+			return err
+		}
+	}
+	if item.ExpiresAt != nil && !item.Expired {
+		if err := k.expiryQueue.Remove(ctx, collections.Join(*item.ExpiresAt, item.Id)); err != nil {
+			return err
+		}
+	}
+	if item.User != "" && item.UserExpiresAt != nil {
+		return k.userExpiryQueue.Remove(ctx, collections.Join(*item.UserExpiresAt, item.Id))
+	}
+	return nil
+}
@@ -2,26 +2,211 @@ package keeper_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/nft"
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
 
 	"omnis/x/omnis/keeper"
 	module "omnis/x/omnis/module"
 	"omnis/x/omnis/types"
+	tokentypes "omnis/x/token/types"
 )
 
 type fixture struct {
 	ctx          context.Context
 	keeper       keeper.Keeper
 	addressCodec address.Codec
+	bankKeeper   *mockBankKeeper
+	nftKeeper    *mockNFTKeeper
+	ics4Wrapper  *mockICS4Wrapper
+	tokenKeeper  *mockTokenKeeper
+}
+
+// mockBankKeeper records balances in memory so that msg server tests can run
+// without a full bank keeper.
+type mockBankKeeper struct {
+	balances map[string]sdk.Coins
+}
+
+func newMockBankKeeper() *mockBankKeeper {
+	return &mockBankKeeper{balances: make(map[string]sdk.Coins)}
+}
+
+func (m *mockBankKeeper) SpendableCoins(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return m.balances[addr.String()]
+}
+
+func (m *mockBankKeeper) SendCoins(_ context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	return m.send(fromAddr.String(), toAddr.String(), amt)
+}
+
+func (m *mockBankKeeper) SendCoinsFromAccountToModule(_ context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return m.send(senderAddr.String(), authtypes.NewModuleAddress(recipientModule).String(), amt)
+}
+
+func (m *mockBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return m.send(authtypes.NewModuleAddress(senderModule).String(), recipientAddr.String(), amt)
+}
+
+func (m *mockBankKeeper) send(from, to string, amt sdk.Coins) error {
+	balance, negative := m.balances[from].SafeSub(amt...)
+	if negative {
+		return fmt.Errorf("insufficient funds in %s", from)
+	}
+	m.balances[from] = balance
+	m.balances[to] = m.balances[to].Add(amt...)
+	return nil
+}
+
+// mockNFTKeeper records NFT classes and NFTs in memory so that the x/nft bridge
+// can be tested without a full nft keeper.
+type mockNFTKeeper struct {
+	classes map[string]nft.Class
+	nfts    map[string]nft.NFT
+	owners  map[string]sdk.AccAddress
+}
+
+func newMockNFTKeeper() *mockNFTKeeper {
+	return &mockNFTKeeper{
+		classes: make(map[string]nft.Class),
+		nfts:    make(map[string]nft.NFT),
+		owners:  make(map[string]sdk.AccAddress),
+	}
+}
+
+func nftKey(classID, nftID string) string {
+	return classID + "|" + nftID
+}
+
+func (m *mockNFTKeeper) SaveClass(_ context.Context, class nft.Class) error {
+	if _, ok := m.classes[class.Id]; ok {
+		return fmt.Errorf("class %s already exists", class.Id)
+	}
+	m.classes[class.Id] = class
+	return nil
+}
+
+func (m *mockNFTKeeper) UpdateClass(_ context.Context, class nft.Class) error {
+	if _, ok := m.classes[class.Id]; !ok {
+		return fmt.Errorf("class %s not found", class.Id)
+	}
+	m.classes[class.Id] = class
+	return nil
+}
+
+func (m *mockNFTKeeper) HasClass(_ context.Context, classID string) bool {
+	_, ok := m.classes[classID]
+	return ok
+}
+
+func (m *mockNFTKeeper) Mint(_ context.Context, token nft.NFT, receiver sdk.AccAddress) error {
+	if _, ok := m.classes[token.ClassId]; !ok {
+		return fmt.Errorf("class %s not found", token.ClassId)
+	}
+	key := nftKey(token.ClassId, token.Id)
+	if _, ok := m.nfts[key]; ok {
+		return fmt.Errorf("nft %s already exists", key)
+	}
+	m.nfts[key] = token
+	m.owners[key] = receiver
+	return nil
+}
+
+func (m *mockNFTKeeper) Burn(_ context.Context, classID, nftID string) error {
+	key := nftKey(classID, nftID)
+	if _, ok := m.nfts[key]; !ok {
+		return fmt.Errorf("nft %s not found", key)
+	}
+	delete(m.nfts, key)
+	delete(m.owners, key)
+	return nil
+}
+
+func (m *mockNFTKeeper) Transfer(_ context.Context, classID, nftID string, receiver sdk.AccAddress) error {
+	key := nftKey(classID, nftID)
+	if _, ok := m.nfts[key]; !ok {
+		return fmt.Errorf("nft %s not found", key)
+	}
+	m.owners[key] = receiver
+	return nil
+}
+
+func (m *mockNFTKeeper) HasNFT(_ context.Context, classID, nftID string) bool {
+	_, ok := m.nfts[nftKey(classID, nftID)]
+	return ok
+}
+
+func (m *mockNFTKeeper) GetOwner(_ context.Context, classID, nftID string) sdk.AccAddress {
+	return m.owners[nftKey(classID, nftID)]
+}
+
+// mockICS4Wrapper records the packets sent instead of handing them to core
+// IBC.
+type mockICS4Wrapper struct {
+	packets []channeltypes.Packet
+}
+
+func (m *mockICS4Wrapper) SendPacket(_ sdk.Context, sourcePort, sourceChannel string, timeoutHeight clienttypes.Height, timeoutTimestamp uint64, data []byte) (uint64, error) {
+	sequence := uint64(len(m.packets) + 1)
+	m.packets = append(m.packets, channeltypes.Packet{
+		Sequence:         sequence,
+		SourcePort:       sourcePort,
+		SourceChannel:    sourceChannel,
+		Data:             data,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+	})
+	return sequence, nil
+}
+
+// mockTokenKeeper issues and burns tokens straight in the balances of the mock
+// bank keeper instead of going through the x/token registry.
+type mockTokenKeeper struct {
+	bankKeeper *mockBankKeeper
+	tokens     map[uint64]tokentypes.Token
+}
+
+func (m *mockTokenKeeper) IssueToken(_ context.Context, issuer string, token tokentypes.Token, recipient sdk.AccAddress) (tokentypes.Token, error) {
+	for _, existing := range m.tokens {
+		if existing.Symbol == token.Symbol {
+			return tokentypes.Token{}, tokentypes.ErrTokenAlreadyExists
+		}
+	}
+	supply, ok := math.NewIntFromString(token.TotalSupply)
+	if !ok {
+		return tokentypes.Token{}, fmt.Errorf("invalid total supply %s", token.TotalSupply)
+	}
+	token.Id = uint64(len(m.tokens))
+	token.Creator = authtypes.NewModuleAddress(issuer).String()
+	m.tokens[token.Id] = token
+	m.bankKeeper.balances[recipient.String()] = m.bankKeeper.balances[recipient.String()].Add(sdk.NewCoin(token.Symbol, supply))
+	return token, nil
+}
+
+func (m *mockTokenKeeper) BurnTokenFrom(_ context.Context, tokenID uint64, holder sdk.AccAddress, amount math.Int) error {
+	token, ok := m.tokens[tokenID]
+	if !ok {
+		return fmt.Errorf("unknown token %d", tokenID)
+	}
+	balance, negative := m.bankKeeper.balances[holder.String()].SafeSub(sdk.NewCoin(token.Symbol, amount))
+	if negative {
+		return fmt.Errorf("insufficient funds in %s", holder)
+	}
+	m.bankKeeper.balances[holder.String()] = balance
+	return nil
 }
 
 func initFixture(t *testing.T) *fixture {
@@ -35,12 +220,20 @@ func initFixture(t *testing.T) *fixture {
 	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
 
 	authority := authtypes.NewModuleAddress(types.GovModuleName)
+	bankKeeper := newMockBankKeeper()
+	nftKeeper := newMockNFTKeeper()
+	ics4Wrapper := &mockICS4Wrapper{}
+	tokenKeeper := &mockTokenKeeper{bankKeeper: bankKeeper, tokens: make(map[uint64]tokentypes.Token)}
 
 	k := keeper.NewKeeper(
 		storeService,
 		encCfg.Codec,
 		addressCodec,
 		authority,
+		bankKeeper,
+		nftKeeper,
+		func() types.ICS4Wrapper { return ics4Wrapper },
+		tokenKeeper,
 	)
 
 	// Initialize params
@@ -52,5 +245,23 @@ func initFixture(t *testing.T) *fixture {
 		ctx:          ctx,
 		keeper:       k,
 		addressCodec: addressCodec,
+		bankKeeper:   bankKeeper,
+		nftKeeper:    nftKeeper,
+		ics4Wrapper:  ics4Wrapper,
+		tokenKeeper:  tokenKeeper,
 	}
 }
+
+// createOpenCollection creates a collection anyone can add items to and
+// returns its namespace.
+func createOpenCollection(t *testing.T, f *fixture, admin string) string {
+	t.Helper()
+
+	_, err := keeper.NewMsgServerImpl(f.keeper).CreateCollection(f.ctx, &types.MsgCreateCollection{
+		Creator:        admin,
+		Namespace:      "default",
+		CreationPolicy: types.CREATION_POLICY_OPEN,
+	})
+	require.NoError(t, err)
+	return "default"
+}
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"omnis/x/omnis/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 sets the params added since version 1 to their defaults and
+// backfills x/nft with the classes and NFTs mirroring the existing collections
+// and items.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if err := m.setDefaultParams(ctx); err != nil {
+		return err
+	}
+	return m.keeper.MirrorNFTs(ctx)
+}
+
+// setDefaultParams sets the params which were never stored, and so read as
+// zero values, to their defaults.
+func (m Migrator) setDefaultParams(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	defaults := types.DefaultParams()
+	if params.MaxItemRevisions == 0 {
+		params.MaxItemRevisions = defaults.MaxItemRevisions
+	}
+	if params.MaxExpirationsPerBlock == 0 {
+		params.MaxExpirationsPerBlock = defaults.MaxExpirationsPerBlock
+	}
+	if params.MarketplaceFee == "" {
+		params.MarketplaceFee = defaults.MarketplaceFee
+	}
+	if params.MarketplaceDenoms == nil {
+		params.MarketplaceDenoms = defaults.MarketplaceDenoms
+	}
+	if params.MaxBatchSize == 0 {
+		params.MaxBatchSize = defaults.MaxBatchSize
+	}
+
+	return m.keeper.Params.Set(ctx, params)
+}
+
+// Migrate2to3 sets the params added since version 2 to their defaults and
+// starts the chain of custody of the existing items.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	if err := m.setDefaultParams(ctx); err != nil {
+		return err
+	}
+	return m.keeper.BackfillProvenance(ctx)
+}
@@ -0,0 +1,42 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"omnis/x/omnis/keeper"
+	"omnis/x/omnis/types"
+)
+
+func TestMigrate1to2Params(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	m := keeper.NewMigrator(f.keeper)
+
+	// Params added since version 1 read as zero values before the migration
+	require.NoError(t, f.keeper.Params.Remove(ctx))
+	require.NoError(t, m.Migrate1to2(ctx))
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams(), params)
+	require.NoError(t, params.Validate())
+}
+
+func TestMigrate2to3Params(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	m := keeper.NewMigrator(f.keeper)
+
+	// Params added since version 2 read as zero values before the migration
+	stored := types.DefaultParams()
+	stored.MaxItemRevisions = 7
+	stored.MaxBatchSize = 0
+	require.NoError(t, f.keeper.Params.Set(ctx, stored))
+	require.NoError(t, m.Migrate2to3(ctx))
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), params.MaxItemRevisions)
+	require.Equal(t, types.DefaultMaxBatchSize, params.MaxBatchSize)
+}
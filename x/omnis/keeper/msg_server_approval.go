@@ -0,0 +1,230 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"omnis/x/omnis/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) ApproveItem(ctx context.Context, msg *types.MsgApproveItem) (*types.MsgApproveItemResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+	operator, err := k.addressCodec.StringToBytes(msg.Operator)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid operator address: %s", err))
+	}
+	if msg.Operator == msg.Creator {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "cannot approve the owner as operator")
+	}
+	if err := validateExpiry(ctx, msg.ExpiresAt); err != nil {
+		return nil, err
+	}
+
+	item, err := k.getOwnedItem(ctx, msg.Id, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	approval := types.ItemApproval{
+		ItemId:    item.Id,
+		Operator:  msg.Operator,
+		ExpiresAt: msg.ExpiresAt,
+	}
+	if err := k.ItemApprovals.Set(ctx, collections.Join(item.Id, sdk.AccAddress(operator)), approval); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set item approval")
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventItemApproved{
+		Id:        item.Id,
+		Owner:     item.Owner,
+		Operator:  msg.Operator,
+		ExpiresAt: msg.ExpiresAt,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgApproveItemResponse{}, nil
+}
+
+func (k msgServer) RevokeItem(ctx context.Context, msg *types.MsgRevokeItem) (*types.MsgRevokeItemResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+	operator, err := k.addressCodec.StringToBytes(msg.Operator)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid operator address: %s", err))
+	}
+
+	item, err := k.getOwnedItem(ctx, msg.Id, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	key := collections.Join(item.Id, sdk.AccAddress(operator))
+	found, err := k.ItemApprovals.Has(ctx, key)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get item approval")
+	}
+	if !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "%s is not approved for item %d", msg.Operator, item.Id)
+	}
+	if err := k.ItemApprovals.Remove(ctx, key); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to delete item approval")
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventItemApprovalRevoked{
+		Id:       item.Id,
+		Owner:    item.Owner,
+		Operator: msg.Operator,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeItemResponse{}, nil
+}
+
+func (k msgServer) ApproveAll(ctx context.Context, msg *types.MsgApproveAll) (*types.MsgApproveAllResponse, error) {
+	owner, err := k.addressCodec.StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+	operator, err := k.addressCodec.StringToBytes(msg.Operator)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid operator address: %s", err))
+	}
+	if msg.Operator == msg.Creator {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "cannot approve the owner as operator")
+	}
+	if err := validateExpiry(ctx, msg.ExpiresAt); err != nil {
+		return nil, err
+	}
+
+	approval := types.OperatorApproval{
+		Owner:     msg.Creator,
+		Operator:  msg.Operator,
+		ExpiresAt: msg.ExpiresAt,
+	}
+	if err := k.OperatorApprovals.Set(ctx, collections.Join(sdk.AccAddress(owner), sdk.AccAddress(operator)), approval); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set operator approval")
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventOperatorApproved{
+		Owner:     msg.Creator,
+		Operator:  msg.Operator,
+		ExpiresAt: msg.ExpiresAt,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgApproveAllResponse{}, nil
+}
+
+func (k msgServer) RevokeAll(ctx context.Context, msg *types.MsgRevokeAll) (*types.MsgRevokeAllResponse, error) {
+	owner, err := k.addressCodec.StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+	operator, err := k.addressCodec.StringToBytes(msg.Operator)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid operator address: %s", err))
+	}
+
+	key := collections.Join(sdk.AccAddress(owner), sdk.AccAddress(operator))
+	found, err := k.OperatorApprovals.Has(ctx, key)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get operator approval")
+	}
+	if !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "%s is not an operator of %s", msg.Operator, msg.Creator)
+	}
+	if err := k.OperatorApprovals.Remove(ctx, key); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to delete operator approval")
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventOperatorRevoked{
+		Owner:    msg.Creator,
+		Operator: msg.Operator,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeAllResponse{}, nil
+}
+
+// getEditableItem returns the item with the given id after checking that
+// sender is its owner or an operator with an active approval, for the item
+// or for all the items of the owner.
+func (k Keeper) getEditableItem(ctx context.Context, id uint64, sender string) (types.Item, error) {
+	item, err := k.getExistingItem(ctx, id)
+	if err != nil {
+		return types.Item{}, err
+	}
+	if err := k.checkEditor(ctx, item, sender); err != nil {
+		return types.Item{}, err
+	}
+	return item, nil
+}
+
+// checkEditor checks that sender is the owner of an item or an operator with
+// an active approval.
+func (k Keeper) checkEditor(ctx context.Context, item types.Item, sender string) error {
+	if item.Owner == sender {
+		return nil
+	}
+
+	approved, err := k.isApprovedOperator(ctx, item, sender)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get approvals")
+	}
+	if !approved {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "neither the owner nor an approved operator")
+	}
+	return nil
+}
+
+// isApprovedOperator returns whether operator has an active approval for the
+// item or for all the items of its owner.
+func (k Keeper) isApprovedOperator(ctx context.Context, item types.Item, operator string) (bool, error) {
+	operatorAddr, err := k.addressCodec.StringToBytes(operator)
+	if err != nil {
+		return false, err
+	}
+	ownerAddr, err := k.addressCodec.StringToBytes(item.Owner)
+	if err != nil {
+		return false, err
+	}
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+
+	itemApproval, err := k.ItemApprovals.Get(ctx, collections.Join(item.Id, sdk.AccAddress(operatorAddr)))
+	switch {
+	case err == nil:
+		if itemApproval.IsActive(now) {
+			return true, nil
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return false, err
+	}
+
+	operatorApproval, err := k.OperatorApprovals.Get(ctx, collections.Join(sdk.AccAddress(ownerAddr), sdk.AccAddress(operatorAddr)))
+	switch {
+	case err == nil:
+		return operatorApproval.IsActive(now), nil
+	case errors.Is(err, collections.ErrNotFound):
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+// clearItemApprovals removes every per-item approval of an item.
+func (k Keeper) clearItemApprovals(ctx context.Context, id uint64) error {
+	return k.ItemApprovals.Clear(ctx, collections.NewPrefixedPairRange[uint64, sdk.AccAddress](id))
+}
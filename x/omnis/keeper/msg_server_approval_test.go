@@ -0,0 +1,149 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"omnis/x/omnis/keeper"
+	"omnis/x/omnis/types"
+)
+
+func TestItemApprovals(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	owner, err := f.addressCodec.BytesToString([]byte("ownerAddr___________________"))
+	require.NoError(t, err)
+	operator, err := f.addressCodec.BytesToString([]byte("operatorAddr________________"))
+	require.NoError(t, err)
+	buyer, err := f.addressCodec.BytesToString([]byte("buyerAddr___________________"))
+	require.NoError(t, err)
+
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	namespace := createOpenCollection(t, f, owner)
+	resp, err := srv.CreateItem(ctx, &types.MsgCreateItem{Creator: owner, Namespace: namespace})
+	require.NoError(t, err)
+
+	_, err = srv.UpdateItem(ctx, &types.MsgUpdateItem{Creator: operator, Id: resp.Id, NewName: "edited"})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	tests := []struct {
+		desc    string
+		request *types.MsgApproveItem
+		err     error
+	}{
+		{
+			desc:    "invalid operator",
+			request: &types.MsgApproveItem{Creator: owner, Id: resp.Id, Operator: "invalid"},
+			err:     sdkerrors.ErrInvalidAddress,
+		},
+		{
+			desc:    "self approval",
+			request: &types.MsgApproveItem{Creator: owner, Id: resp.Id, Operator: owner},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "not the owner",
+			request: &types.MsgApproveItem{Creator: operator, Id: resp.Id, Operator: buyer},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "key not found",
+			request: &types.MsgApproveItem{Creator: owner, Id: 10, Operator: operator},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "completed",
+			request: &types.MsgApproveItem{Creator: owner, Id: resp.Id, Operator: operator},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.ApproveItem(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	approvals, err := qs.ItemApprovals(ctx, &types.QueryItemApprovalsRequest{Id: resp.Id})
+	require.NoError(t, err)
+	require.Len(t, approvals.Approvals, 1)
+
+	// The operator edits on behalf of the owner, and the history shows it
+	_, err = srv.UpdateItem(ctx, &types.MsgUpdateItem{Creator: operator, Id: resp.Id, NewName: "edited"})
+	require.NoError(t, err)
+	history, err := qs.ItemHistory(ctx, &types.QueryItemHistoryRequest{Id: resp.Id})
+	require.NoError(t, err)
+	require.Equal(t, operator, history.Revisions[len(history.Revisions)-1].Editor)
+
+	// Transferring the item clears the approvals of the previous owner
+	_, err = srv.TransferItem(ctx, &types.MsgTransferItem{Creator: operator, Id: resp.Id, NewOwner: buyer})
+	require.NoError(t, err)
+	item, err := f.keeper.GetItem(ctx, resp.Id)
+	require.NoError(t, err)
+	require.Equal(t, buyer, item.Owner)
+
+	approvals, err = qs.ItemApprovals(ctx, &types.QueryItemApprovalsRequest{Id: resp.Id})
+	require.NoError(t, err)
+	require.Empty(t, approvals.Approvals)
+	_, err = srv.UpdateItem(ctx, &types.MsgUpdateItem{Creator: operator, Id: resp.Id, NewName: "again"})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = srv.RevokeItem(ctx, &types.MsgRevokeItem{Creator: buyer, Id: resp.Id, Operator: operator})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+}
+
+func TestOperatorApprovals(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	owner, err := f.addressCodec.BytesToString([]byte("ownerAddr___________________"))
+	require.NoError(t, err)
+	operator, err := f.addressCodec.BytesToString([]byte("operatorAddr________________"))
+	require.NoError(t, err)
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+	namespace := createOpenCollection(t, f, owner)
+	first, err := srv.CreateItem(ctx, &types.MsgCreateItem{Creator: owner, Namespace: namespace})
+	require.NoError(t, err)
+	second, err := srv.CreateItem(ctx, &types.MsgCreateItem{Creator: owner, Namespace: namespace})
+	require.NoError(t, err)
+
+	past := now.Add(-time.Hour)
+	_, err = srv.ApproveAll(ctx, &types.MsgApproveAll{Creator: owner, Operator: operator, ExpiresAt: &past})
+	require.ErrorIs(t, err, types.ErrInvalidExpiry)
+
+	expiresAt := now.Add(time.Hour)
+	_, err = srv.ApproveAll(ctx, &types.MsgApproveAll{Creator: owner, Operator: operator, ExpiresAt: &expiresAt})
+	require.NoError(t, err)
+
+	approvals, err := qs.OperatorApprovals(ctx, &types.QueryOperatorApprovalsRequest{Owner: owner})
+	require.NoError(t, err)
+	require.Len(t, approvals.Approvals, 1)
+
+	_, err = srv.DeleteItem(ctx, &types.MsgDeleteItem{Creator: operator, Id: first.Id})
+	require.NoError(t, err)
+
+	// The approval lapses at its expiry time
+	ctx = ctx.WithBlockTime(expiresAt)
+	_, err = srv.DeleteItem(ctx, &types.MsgDeleteItem{Creator: operator, Id: second.Id})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	approvals, err = qs.OperatorApprovals(ctx, &types.QueryOperatorApprovalsRequest{Owner: owner})
+	require.NoError(t, err)
+	require.Empty(t, approvals.Approvals)
+
+	_, err = srv.RevokeAll(ctx, &types.MsgRevokeAll{Creator: owner, Operator: operator})
+	require.NoError(t, err)
+	_, err = srv.RevokeAll(ctx, &types.MsgRevokeAll{Creator: owner, Operator: operator})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+}
@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"omnis/x/omnis/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) Attest(ctx context.Context, msg *types.MsgAttest) (*types.MsgAttestResponse, error) {
+	attester, err := k.addressCodec.StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+	if err := types.ValidateClaimType(msg.ClaimType); err != nil {
+		return nil, err
+	}
+	if err := msg.PayloadHash.Validate(); err != nil {
+		return nil, err
+	}
+	if err := validateExpiry(ctx, msg.ExpiresAt); err != nil {
+		return nil, err
+	}
+
+	// Attesters do not need to own the item, but must be trusted by its
+	// collection
+	item, err := k.getExistingItem(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	collection, err := k.getCollection(ctx, item.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if !collection.IsAttester(msg.Creator) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "not an attester of collection %s", collection.Namespace)
+	}
+
+	attestation := types.Attestation{
+		ItemId:      item.Id,
+		Attester:    msg.Creator,
+		ClaimType:   msg.ClaimType,
+		PayloadHash: msg.PayloadHash,
+		ExpiresAt:   msg.ExpiresAt,
+		AttestedAt:  sdk.UnwrapSDKContext(ctx).BlockTime(),
+	}
+	if err := k.Attestations.Set(ctx, collections.Join3(item.Id, sdk.AccAddress(attester), msg.ClaimType), attestation); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set attestation")
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventItemAttested{
+		Id:          item.Id,
+		Attester:    msg.Creator,
+		ClaimType:   msg.ClaimType,
+		PayloadHash: msg.PayloadHash,
+		ExpiresAt:   msg.ExpiresAt,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAttestResponse{}, nil
+}
+
+func (k msgServer) RevokeAttestation(ctx context.Context, msg *types.MsgRevokeAttestation) (*types.MsgRevokeAttestationResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+	attester := msg.Attester
+	if attester == "" {
+		attester = msg.Creator
+	}
+	attesterAddr, err := k.addressCodec.StringToBytes(attester)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid attester address: %s", err))
+	}
+
+	// The admin of the collection may revoke the attestations of attesters
+	// it no longer trusts
+	item, err := k.getExistingItem(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	if attester != msg.Creator {
+		if _, err := k.getAdministeredCollection(ctx, item.Namespace, msg.Creator); err != nil {
+			return nil, err
+		}
+	}
+
+	key := collections.Join3(item.Id, sdk.AccAddress(attesterAddr), msg.ClaimType)
+	found, err := k.Attestations.Has(ctx, key)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get attestation")
+	}
+	if !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "no %s attestation of item %d by %s", msg.ClaimType, item.Id, attester)
+	}
+	if err := k.Attestations.Remove(ctx, key); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to delete attestation")
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventAttestationRevoked{
+		Id:        item.Id,
+		Attester:  attester,
+		ClaimType: msg.ClaimType,
+		Revoker:   msg.Creator,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeAttestationResponse{}, nil
+}
+
+// clearItemAttestations removes the attestations of an item.
+func (k Keeper) clearItemAttestations(ctx context.Context, id uint64) error {
+	iter, err := k.Attestations.Iterate(ctx, collections.NewPrefixedTripleRange[uint64, sdk.AccAddress, string](id))
+	if err != nil {
+		return err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := k.Attestations.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
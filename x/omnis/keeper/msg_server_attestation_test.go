@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"omnis/x/omnis/keeper"
+	"omnis/x/omnis/types"
+)
+
+func TestAttestations(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+
+	admin, err := f.addressCodec.BytesToString([]byte("adminAddr___________________"))
+	require.NoError(t, err)
+	inspector, err := f.addressCodec.BytesToString([]byte("inspectorAddr_______________"))
+	require.NoError(t, err)
+	other, err := f.addressCodec.BytesToString([]byte("otherAddr___________________"))
+	require.NoError(t, err)
+
+	_, err = srv.CreateCollection(ctx, &types.MsgCreateCollection{
+		Creator:        admin,
+		Namespace:      "watches",
+		CreationPolicy: types.CREATION_POLICY_OPEN,
+		Attesters:      []string{inspector},
+	})
+	require.NoError(t, err)
+	item, err := srv.CreateItem(ctx, &types.MsgCreateItem{Creator: other, Namespace: "watches"})
+	require.NoError(t, err)
+
+	digest := sha256.Sum256([]byte("certificate"))
+	payloadHash := types.ContentHash{Algorithm: types.HASH_ALGORITHM_SHA256, Hash: hex.EncodeToString(digest[:])}
+	past, future := now.Add(-time.Hour), now.Add(time.Hour)
+
+	tests := []struct {
+		desc    string
+		request *types.MsgAttest
+		err     error
+	}{
+		{
+			desc:    "not an attester",
+			request: types.NewMsgAttest(other, item.Id, "authentic", payloadHash, nil),
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "invalid claim type",
+			request: types.NewMsgAttest(inspector, item.Id, "passed QA", payloadHash, nil),
+			err:     types.ErrInvalidAttestation,
+		},
+		{
+			desc:    "invalid payload hash",
+			request: types.NewMsgAttest(inspector, item.Id, "authentic", types.ContentHash{Hash: payloadHash.Hash}, nil),
+			err:     types.ErrInvalidContentHash,
+		},
+		{
+			desc:    "expiry in the past",
+			request: types.NewMsgAttest(inspector, item.Id, "authentic", payloadHash, &past),
+			err:     types.ErrInvalidExpiry,
+		},
+		{
+			desc:    "key not found",
+			request: types.NewMsgAttest(inspector, 10, "authentic", payloadHash, nil),
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "completed",
+			request: types.NewMsgAttest(inspector, item.Id, "authentic", payloadHash, nil),
+		},
+		{
+			desc:    "completed with expiry",
+			request: types.NewMsgAttest(inspector, item.Id, "passed-qa", payloadHash, &future),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.Attest(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	byItem, err := qs.AttestationsByItem(ctx, &types.QueryAttestationsByItemRequest{Id: item.Id})
+	require.NoError(t, err)
+	require.Len(t, byItem.Attestations, 2)
+	require.Equal(t, inspector, byItem.Attestations[0].Attester)
+	require.Equal(t, now, byItem.Attestations[0].AttestedAt)
+	byAttester, err := qs.AttestationsByAttester(ctx, &types.QueryAttestationsByAttesterRequest{Attester: inspector})
+	require.NoError(t, err)
+	require.Equal(t, byItem.Attestations, byAttester.Attestations)
+
+	// Expired attestations are kept, but can be filtered out
+	later := ctx.WithBlockTime(future)
+	byItem, err = qs.AttestationsByItem(later, &types.QueryAttestationsByItemRequest{Id: item.Id, ActiveOnly: true})
+	require.NoError(t, err)
+	require.Len(t, byItem.Attestations, 1)
+	require.Equal(t, "authentic", byItem.Attestations[0].ClaimType)
+	byAttester, err = qs.AttestationsByAttester(later, &types.QueryAttestationsByAttesterRequest{Attester: inspector, ActiveOnly: true})
+	require.NoError(t, err)
+	require.Len(t, byAttester.Attestations, 1)
+
+	// Only the attester or the collection admin may revoke an attestation
+	_, err = srv.RevokeAttestation(ctx, types.NewMsgRevokeAttestation(other, item.Id, inspector, "authentic"))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.RevokeAttestation(ctx, types.NewMsgRevokeAttestation(other, item.Id, "", "authentic"))
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	_, err = srv.RevokeAttestation(ctx, types.NewMsgRevokeAttestation(inspector, item.Id, "", "passed-qa"))
+	require.NoError(t, err)
+	_, err = srv.RevokeAttestation(ctx, types.NewMsgRevokeAttestation(admin, item.Id, inspector, "authentic"))
+	require.NoError(t, err)
+	byItem, err = qs.AttestationsByItem(ctx, &types.QueryAttestationsByItemRequest{Id: item.Id})
+	require.NoError(t, err)
+	require.Empty(t, byItem.Attestations)
+
+	// The attestations of an item are deleted with it
+	_, err = srv.Attest(ctx, types.NewMsgAttest(inspector, item.Id, "authentic", payloadHash, nil))
+	require.NoError(t, err)
+	_, err = srv.DeleteItem(ctx, &types.MsgDeleteItem{Creator: other, Id: item.Id})
+	require.NoError(t, err)
+	byAttester, err = qs.AttestationsByAttester(ctx, &types.QueryAttestationsByAttesterRequest{Attester: inspector})
+	require.NoError(t, err)
+	require.Empty(t, byAttester.Attestations)
+}
@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"omnis/x/omnis/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) SetItemAttributes(ctx context.Context, msg *types.MsgSetItemAttributes) (*types.MsgSetItemAttributesResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	if len(msg.Attributes) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no attributes to set")
+	}
+	updates, err := types.NormalizeAttributes(msg.Attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := k.getEditableItem(ctx, msg.Id, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	prev := item
+	item.Attributes = types.MergeAttributes(item.Attributes, updates)
+	if err := k.validateItemAttributes(ctx, item); err != nil {
+		return nil, err
+	}
+	if err := k.recordItemRevision(ctx, prev, &item, msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to record item revision")
+	}
+
+	if err := k.SetItem(ctx, item); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update item")
+	}
+
+	keys := make([]string, len(updates))
+	for i, attr := range updates {
+		keys[i] = attr.Key
+	}
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventItemAttributesSet{
+		Id:   item.Id,
+		Keys: keys,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetItemAttributesResponse{}, nil
+}
+
+func (k msgServer) RemoveItemAttributes(ctx context.Context, msg *types.MsgRemoveItemAttributes) (*types.MsgRemoveItemAttributesResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	if len(msg.Keys) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no attributes to remove")
+	}
+
+	item, err := k.getEditableItem(ctx, msg.Id, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	prev := item
+	item.Attributes, err = types.RemoveAttributes(item.Attributes, msg.Keys)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.validateItemAttributes(ctx, item); err != nil {
+		return nil, err
+	}
+	if err := k.recordItemRevision(ctx, prev, &item, msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to record item revision")
+	}
+
+	if err := k.SetItem(ctx, item); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update item")
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventItemAttributesRemoved{
+		Id:   item.Id,
+		Keys: msg.Keys,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveItemAttributesResponse{}, nil
+}
+
+func (k msgServer) SetAttributeSchema(ctx context.Context, msg *types.MsgSetAttributeSchema) (*types.MsgSetAttributeSchemaResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	collection, err := k.getAdministeredCollection(ctx, msg.Namespace, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	// Items already in the collection are not revalidated; the new schema
+	// applies to them the next time their attributes change.
+	schema := types.AttributeSchema{
+		Namespace:   collection.Namespace,
+		Definitions: msg.Definitions,
+	}
+	if err := schema.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := k.AttributeSchema.Set(ctx, schema.Namespace, schema); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set attribute schema")
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventAttributeSchemaSet{
+		Namespace: schema.Namespace,
+		Admin:     collection.Admin,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetAttributeSchemaResponse{}, nil
+}
+
+// validateNewItemAttributes normalizes the attributes of an item being
+// created in the collection namespace.
+func (k Keeper) validateNewItemAttributes(ctx context.Context, namespace string, attrs []types.Attribute) ([]types.Attribute, error) {
+	normalized, err := types.NormalizeAttributes(attrs)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.validateItemAttributes(ctx, types.Item{Namespace: namespace, Attributes: normalized}); err != nil {
+		return nil, err
+	}
+	return normalized, nil
+}
+
+// validateItemAttributes checks the attributes of an item against the schema
+// of its collection.
+func (k Keeper) validateItemAttributes(ctx context.Context, item types.Item) error {
+	schema, err := k.GetAttributeSchema(ctx, item.Namespace)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get attribute schema")
+	}
+	return types.ValidateItemAttributes(item.Attributes, schema)
+}
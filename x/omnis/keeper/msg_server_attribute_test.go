@@ -0,0 +1,159 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"omnis/x/omnis/keeper"
+	"omnis/x/omnis/types"
+)
+
+func TestItemMsgServerAttributes(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	unauthorizedAddr, err := f.addressCodec.BytesToString([]byte("unauthorizedAddr___________"))
+	require.NoError(t, err)
+	operator, err := f.addressCodec.BytesToString([]byte("operatorAddr________________"))
+	require.NoError(t, err)
+
+	_, err = srv.CreateCollection(f.ctx, &types.MsgCreateCollection{
+		Creator:        creator,
+		Namespace:      "logistics",
+		CreationPolicy: types.CREATION_POLICY_OPEN,
+	})
+	require.NoError(t, err)
+
+	_, err = srv.SetAttributeSchema(f.ctx, &types.MsgSetAttributeSchema{
+		Creator:   creator,
+		Namespace: "logistics",
+		Definitions: []types.AttributeDefinition{
+			{Key: "weight", Type: types.ATTRIBUTE_TYPE_INT, Required: true},
+			{Key: "origin", Type: types.ATTRIBUTE_TYPE_STRING},
+		},
+	})
+	require.NoError(t, err)
+
+	// Only the collection admin can replace the schema
+	_, err = srv.SetAttributeSchema(f.ctx, &types.MsgSetAttributeSchema{Creator: unauthorizedAddr, Namespace: "logistics"})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = srv.SetAttributeSchema(f.ctx, &types.MsgSetAttributeSchema{Creator: creator, Namespace: "unknown"})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	// Required attributes must be set at creation
+	_, err = srv.CreateItem(f.ctx, &types.MsgCreateItem{Creator: creator, Namespace: "logistics"})
+	require.ErrorIs(t, err, types.ErrSchemaViolation)
+
+	resp, err := srv.CreateItem(f.ctx, &types.MsgCreateItem{
+		Creator:    creator,
+		Namespace:  "logistics",
+		Attributes: []types.Attribute{{Key: "weight", Type: types.ATTRIBUTE_TYPE_INT, Value: "010"}},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc    string
+		request *types.MsgSetItemAttributes
+		err     error
+	}{
+		{
+			desc:    "invalid address",
+			request: &types.MsgSetItemAttributes{Creator: "invalid", Id: resp.Id},
+			err:     sdkerrors.ErrInvalidAddress,
+		},
+		{
+			desc: "unauthorized",
+			request: &types.MsgSetItemAttributes{Creator: unauthorizedAddr, Id: resp.Id, Attributes: []types.Attribute{
+				{Key: "origin", Type: types.ATTRIBUTE_TYPE_STRING, Value: "Hamburg"},
+			}},
+			err: sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc: "undefined attribute",
+			request: &types.MsgSetItemAttributes{Creator: creator, Id: resp.Id, Attributes: []types.Attribute{
+				{Key: "color", Type: types.ATTRIBUTE_TYPE_STRING, Value: "red"},
+			}},
+			err: types.ErrSchemaViolation,
+		},
+		{
+			desc: "invalid value",
+			request: &types.MsgSetItemAttributes{Creator: creator, Id: resp.Id, Attributes: []types.Attribute{
+				{Key: "weight", Type: types.ATTRIBUTE_TYPE_INT, Value: "heavy"},
+			}},
+			err: types.ErrInvalidAttribute,
+		},
+		{
+			desc: "completed",
+			request: &types.MsgSetItemAttributes{Creator: creator, Id: resp.Id, Attributes: []types.Attribute{
+				{Key: "origin", Type: types.ATTRIBUTE_TYPE_STRING, Value: "Hamburg"},
+			}},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err = srv.SetItemAttributes(f.ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	item, err := f.keeper.GetItem(f.ctx, resp.Id)
+	require.NoError(t, err)
+	require.Equal(t, []types.Attribute{
+		{Key: "origin", Type: types.ATTRIBUTE_TYPE_STRING, Value: "Hamburg"},
+		{Key: "weight", Type: types.ATTRIBUTE_TYPE_INT, Value: "10"},
+	}, item.Attributes)
+
+	// Values are matched in canonical form
+	found, err := qs.ItemsByAttribute(f.ctx, &types.QueryItemsByAttributeRequest{Namespace: "logistics", Key: "weight", Value: "0010"})
+	require.NoError(t, err)
+	require.Len(t, found.Items, 1)
+
+	// Required attributes cannot be removed
+	_, err = srv.RemoveItemAttributes(f.ctx, &types.MsgRemoveItemAttributes{Creator: creator, Id: resp.Id, Keys: []string{"weight"}})
+	require.ErrorIs(t, err, types.ErrSchemaViolation)
+
+	_, err = srv.RemoveItemAttributes(f.ctx, &types.MsgRemoveItemAttributes{Creator: creator, Id: resp.Id, Keys: []string{"color"}})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	_, err = srv.RemoveItemAttributes(f.ctx, &types.MsgRemoveItemAttributes{Creator: creator, Id: resp.Id, Keys: []string{"origin"}})
+	require.NoError(t, err)
+
+	found, err = qs.ItemsByAttribute(f.ctx, &types.QueryItemsByAttributeRequest{Namespace: "logistics", Key: "origin", Value: "Hamburg"})
+	require.NoError(t, err)
+	require.Empty(t, found.Items)
+
+	// Approved operators edit the attributes on behalf of the owner
+	origin := []types.Attribute{{Key: "origin", Type: types.ATTRIBUTE_TYPE_STRING, Value: "Bremen"}}
+	_, err = srv.SetItemAttributes(f.ctx, &types.MsgSetItemAttributes{Creator: operator, Id: resp.Id, Attributes: origin})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.RemoveItemAttributes(f.ctx, &types.MsgRemoveItemAttributes{Creator: operator, Id: resp.Id, Keys: []string{"origin"}})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = srv.ApproveItem(f.ctx, &types.MsgApproveItem{Creator: creator, Id: resp.Id, Operator: operator})
+	require.NoError(t, err)
+	_, err = srv.SetItemAttributes(f.ctx, &types.MsgSetItemAttributes{Creator: operator, Id: resp.Id, Attributes: origin})
+	require.NoError(t, err)
+	found, err = qs.ItemsByAttribute(f.ctx, &types.QueryItemsByAttributeRequest{Namespace: "logistics", Key: "origin", Value: "Bremen"})
+	require.NoError(t, err)
+	require.Len(t, found.Items, 1)
+	_, err = srv.RemoveItemAttributes(f.ctx, &types.MsgRemoveItemAttributes{Creator: operator, Id: resp.Id, Keys: []string{"origin"}})
+	require.NoError(t, err)
+
+	// Deleting the item clears the attribute index
+	_, err = srv.DeleteItem(f.ctx, &types.MsgDeleteItem{Creator: creator, Id: resp.Id})
+	require.NoError(t, err)
+	found, err = qs.ItemsByAttribute(f.ctx, &types.QueryItemsByAttributeRequest{Namespace: "logistics", Key: "weight", Value: "10"})
+	require.NoError(t, err)
+	require.Empty(t, found.Items)
+}
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"omnis/x/omnis/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// BatchCreateItems creates the items of the batch one after the other, as
+// many CreateItem messages would. A failing item fails the whole message, and
+// the state changes of the items created before it are discarded with the
+// transaction.
+func (k msgServer) BatchCreateItems(ctx context.Context, msg *types.MsgBatchCreateItems) (*types.MsgBatchCreateItemsResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+	if err := k.validateBatchSize(ctx, len(msg.Items)); err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint64, 0, len(msg.Items))
+	for i, item := range msg.Items {
+		resp, err := k.CreateItem(ctx, &types.MsgCreateItem{
+			Creator:    msg.Creator,
+			Name:       item.Name,
+			Alias:      item.Alias,
+			Namespace:  item.Namespace,
+			Attributes: item.Attributes,
+			ExpiresAt:  item.ExpiresAt,
+			Royalty:    item.Royalty,
+		})
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "item %d of the batch", i)
+		}
+		ids = append(ids, resp.Id)
+	}
+
+	return &types.MsgBatchCreateItemsResponse{Ids: ids}, nil
+}
+
+// BatchUpdateItems renames the items of the batch one after the other, as
+// many UpdateItem messages would. A failing item fails the whole message.
+func (k msgServer) BatchUpdateItems(ctx context.Context, msg *types.MsgBatchUpdateItems) (*types.MsgBatchUpdateItemsResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+	if err := k.validateBatchSize(ctx, len(msg.Items)); err != nil {
+		return nil, err
+	}
+
+	seen := make(map[uint64]bool, len(msg.Items))
+	for i, item := range msg.Items {
+		if seen[item.Id] {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "item %d is updated twice in the batch", item.Id)
+		}
+		seen[item.Id] = true
+
+		if _, err := k.UpdateItem(ctx, &types.MsgUpdateItem{
+			Creator: msg.Creator,
+			Id:      item.Id,
+			NewName: item.NewName,
+		}); err != nil {
+			return nil, errorsmod.Wrapf(err, "item %d of the batch", i)
+		}
+	}
+
+	return &types.MsgBatchUpdateItemsResponse{}, nil
+}
+
+// validateBatchSize checks that a batch holds at least one item and at most
+// MaxBatchSize items.
+func (k Keeper) validateBatchSize(ctx context.Context, size int) error {
+	if size == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty batch")
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get params")
+	}
+	if uint64(size) > params.MaxBatchSize {
+		return errorsmod.Wrapf(types.ErrBatchTooLarge, "%d items, the maximum is %d", size, params.MaxBatchSize)
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"omnis/x/omnis/keeper"
+	"omnis/x/omnis/types"
+)
+
+func TestBatchCreateItems(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.MaxBatchSize = 3
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	namespace := createOpenCollection(t, f, creator)
+
+	items := func(aliases ...string) []types.BatchItem {
+		var items []types.BatchItem
+		for _, alias := range aliases {
+			items = append(items, types.BatchItem{Name: alias, Alias: alias, Namespace: namespace})
+		}
+		return items
+	}
+	tests := []struct {
+		desc    string
+		request *types.MsgBatchCreateItems
+		err     error
+	}{
+		{
+			desc:    "empty batch",
+			request: types.NewMsgBatchCreateItems(creator, nil),
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "batch too large",
+			request: types.NewMsgBatchCreateItems(creator, items("a", "b", "c", "d")),
+			err:     types.ErrBatchTooLarge,
+		},
+		{
+			desc:    "duplicated alias",
+			request: types.NewMsgBatchCreateItems(creator, items("a", "b", "a")),
+			err:     types.ErrItemAlreadyExists,
+		},
+		{
+			desc:    "invalid address",
+			request: types.NewMsgBatchCreateItems("invalid", items("a")),
+			err:     sdkerrors.ErrInvalidAddress,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			// Failed messages are discarded with their transaction
+			cacheCtx, _ := sdk.UnwrapSDKContext(f.ctx).CacheContext()
+			_, err := srv.BatchCreateItems(cacheCtx, tc.request)
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+
+	resp, err := srv.BatchCreateItems(f.ctx, types.NewMsgBatchCreateItems(creator, items("a", "b", "c")))
+	require.NoError(t, err)
+	require.Equal(t, []uint64{0, 1, 2}, resp.Ids)
+	item, err := f.keeper.GetItem(f.ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, "c", item.Alias)
+	require.Equal(t, creator, item.Owner)
+}
+
+func TestBatchUpdateItems(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	other, err := f.addressCodec.BytesToString([]byte("otherAddr___________________"))
+	require.NoError(t, err)
+
+	namespace := createOpenCollection(t, f, creator)
+	created, err := srv.BatchCreateItems(f.ctx, types.NewMsgBatchCreateItems(creator, []types.BatchItem{
+		{Name: "a", Namespace: namespace},
+		{Name: "b", Namespace: namespace},
+	}))
+	require.NoError(t, err)
+	_, err = srv.CreateItem(f.ctx, &types.MsgCreateItem{Creator: other, Namespace: namespace})
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc    string
+		request *types.MsgBatchUpdateItems
+		err     error
+	}{
+		{
+			desc:    "not the owner",
+			request: types.NewMsgBatchUpdateItems(creator, []types.BatchItemUpdate{{Id: 0, NewName: "x"}, {Id: 2, NewName: "y"}}),
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "duplicated item",
+			request: types.NewMsgBatchUpdateItems(creator, []types.BatchItemUpdate{{Id: 0, NewName: "x"}, {Id: 0, NewName: "y"}}),
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "key not found",
+			request: types.NewMsgBatchUpdateItems(creator, []types.BatchItemUpdate{{Id: 10, NewName: "x"}}),
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc: "completed",
+			request: types.NewMsgBatchUpdateItems(creator, []types.BatchItemUpdate{
+				{Id: created.Ids[0], NewName: "x"},
+				{Id: created.Ids[1], NewName: "y"},
+			}),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			cacheCtx, write := sdk.UnwrapSDKContext(f.ctx).CacheContext()
+			_, err := srv.BatchUpdateItems(cacheCtx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				write()
+			}
+		})
+	}
+
+	for i, name := range []string{"x", "y"} {
+		item, err := f.keeper.GetItem(f.ctx, created.Ids[i])
+		require.NoError(t, err)
+		require.Equal(t, name, item.Name)
+	}
+}
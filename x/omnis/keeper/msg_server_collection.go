@@ -0,0 +1,185 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"omnis/x/omnis/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) CreateCollection(ctx context.Context, msg *types.MsgCreateCollection) (*types.MsgCreateCollectionResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	collection := types.ItemCollection{
+		Namespace:          msg.Namespace,
+		Admin:              msg.Creator,
+		Description:        msg.Description,
+		CreationPolicy:     msg.CreationPolicy,
+		Allowlist:          msg.Allowlist,
+		MaxItems:           msg.MaxItems,
+		MaxItemsPerCreator: msg.MaxItemsPerCreator,
+		ExpiryAction:       msg.ExpiryAction,
+		Royalty:            msg.Royalty,
+		Attesters:          msg.Attesters,
+	}
+	if err := collection.Validate(); err != nil {
+		return nil, err
+	}
+	if types.IsVoucherNamespace(collection.Namespace) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidNamespace, "namespaces starting with %s are reserved for ICS-721 vouchers", types.VoucherPrefix)
+	}
+
+	found, err := k.ItemCollection.Has(ctx, msg.Namespace)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get collection")
+	}
+	if found {
+		return nil, errorsmod.Wrapf(types.ErrCollectionExists, "collection %s already exists", msg.Namespace)
+	}
+
+	if err := k.ItemCollection.Set(ctx, collection.Namespace, collection); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set collection")
+	}
+	if err := k.saveCollectionClass(ctx, collection); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to save collection nft class")
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventCollectionCreated{
+		Namespace: collection.Namespace,
+		Admin:     collection.Admin,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateCollectionResponse{}, nil
+}
+
+func (k msgServer) UpdateCollection(ctx context.Context, msg *types.MsgUpdateCollection) (*types.MsgUpdateCollectionResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	collection, err := k.getAdministeredCollection(ctx, msg.Namespace, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	// Lowering the limits or changing the royalty does not affect the items
+	// already in the collection.
+	if msg.NewAdmin != "" {
+		collection.Admin = msg.NewAdmin
+	}
+	collection.Description = msg.Description
+	collection.CreationPolicy = msg.CreationPolicy
+	collection.Allowlist = msg.Allowlist
+	collection.MaxItems = msg.MaxItems
+	collection.MaxItemsPerCreator = msg.MaxItemsPerCreator
+	collection.ExpiryAction = msg.ExpiryAction
+	collection.Royalty = msg.Royalty
+	collection.Attesters = msg.Attesters
+	if err := collection.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := k.ItemCollection.Set(ctx, collection.Namespace, collection); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update collection")
+	}
+	if err := k.saveCollectionClass(ctx, collection); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to save collection nft class")
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventCollectionUpdated{
+		Namespace: collection.Namespace,
+		Admin:     collection.Admin,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateCollectionResponse{}, nil
+}
+
+// getCollection returns the collection of a namespace.
+func (k Keeper) getCollection(ctx context.Context, namespace string) (types.ItemCollection, error) {
+	collection, err := k.ItemCollection.Get(ctx, namespace)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.ItemCollection{}, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("collection %s doesn't exist", namespace))
+		}
+
+		return types.ItemCollection{}, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get collection")
+	}
+	return collection, nil
+}
+
+// getAdministeredCollection returns the collection of a namespace after
+// checking that it is administered by admin.
+func (k Keeper) getAdministeredCollection(ctx context.Context, namespace string, admin string) (types.ItemCollection, error) {
+	collection, err := k.getCollection(ctx, namespace)
+	if err != nil {
+		return types.ItemCollection{}, err
+	}
+	if collection.Admin != admin {
+		return types.ItemCollection{}, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect collection admin")
+	}
+	return collection, nil
+}
+
+// addCollectionItem records a new item created by creator in the collection,
+// enforcing the collection limits.
+func (k Keeper) addCollectionItem(ctx context.Context, collection types.ItemCollection, creator sdk.AccAddress) error {
+	count, err := k.CollectionItemCount.Get(ctx, collection.Namespace)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if collection.MaxItems > 0 && count >= collection.MaxItems {
+		return errorsmod.Wrapf(types.ErrCollectionFull, "collection %s is limited to %d items", collection.Namespace, collection.MaxItems)
+	}
+
+	creatorKey := collections.Join(collection.Namespace, creator)
+	creatorCount, err := k.CollectionCreatorCount.Get(ctx, creatorKey)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if collection.MaxItemsPerCreator > 0 && creatorCount >= collection.MaxItemsPerCreator {
+		return errorsmod.Wrapf(types.ErrCollectionFull, "collection %s is limited to %d items per creator", collection.Namespace, collection.MaxItemsPerCreator)
+	}
+
+	if err := k.CollectionItemCount.Set(ctx, collection.Namespace, count+1); err != nil {
+		return err
+	}
+	return k.CollectionCreatorCount.Set(ctx, creatorKey, creatorCount+1)
+}
+
+// removeCollectionItem releases the slot of a deleted item in its collection.
+func (k Keeper) removeCollectionItem(ctx context.Context, item types.Item) error {
+	creator, err := k.addressCodec.StringToBytes(item.Creator)
+	if err != nil {
+		return err
+	}
+
+	count, err := k.CollectionItemCount.Get(ctx, item.Namespace)
+	if err != nil {
+		return err
+	}
+	if err := k.CollectionItemCount.Set(ctx, item.Namespace, count-1); err != nil {
+		return err
+	}
+
+	creatorKey := collections.Join(item.Namespace, sdk.AccAddress(creator))
+	creatorCount, err := k.CollectionCreatorCount.Get(ctx, creatorKey)
+	if err != nil {
+		return err
+	}
+	if creatorCount <= 1 {
+		return k.CollectionCreatorCount.Remove(ctx, creatorKey)
+	}
+	return k.CollectionCreatorCount.Set(ctx, creatorKey, creatorCount-1)
+}
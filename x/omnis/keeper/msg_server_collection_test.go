@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"omnis/x/omnis/keeper"
+	"omnis/x/omnis/types"
+)
+
+func TestCollectionMsgServerCreate(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	admin, err := f.addressCodec.BytesToString([]byte("adminAddr___________________"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc    string
+		request *types.MsgCreateCollection
+		err     error
+	}{
+		{
+			desc:    "invalid address",
+			request: &types.MsgCreateCollection{Creator: "invalid", Namespace: "logistics", CreationPolicy: types.CREATION_POLICY_OPEN},
+			err:     sdkerrors.ErrInvalidAddress,
+		},
+		{
+			desc:    "invalid namespace",
+			request: &types.MsgCreateCollection{Creator: admin, Namespace: "", CreationPolicy: types.CREATION_POLICY_OPEN},
+			err:     types.ErrInvalidNamespace,
+		},
+		{
+			desc:    "unspecified policy",
+			request: &types.MsgCreateCollection{Creator: admin, Namespace: "logistics"},
+			err:     types.ErrInvalidCollection,
+		},
+		{
+			desc:    "allowlist without policy",
+			request: &types.MsgCreateCollection{Creator: admin, Namespace: "logistics", CreationPolicy: types.CREATION_POLICY_OPEN, Allowlist: []string{admin}},
+			err:     types.ErrInvalidCollection,
+		},
+		{
+			desc:    "completed",
+			request: &types.MsgCreateCollection{Creator: admin, Namespace: "logistics", CreationPolicy: types.CREATION_POLICY_OPEN},
+		},
+		{
+			desc:    "already exists",
+			request: &types.MsgCreateCollection{Creator: admin, Namespace: "logistics", CreationPolicy: types.CREATION_POLICY_OPEN},
+			err:     types.ErrCollectionExists,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.CreateCollection(f.ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				collection, err := f.keeper.ItemCollection.Get(f.ctx, tc.request.Namespace)
+				require.NoError(t, err)
+				require.Equal(t, admin, collection.Admin)
+			}
+		})
+	}
+}
+
+func TestCollectionMsgServerPolicies(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	admin, err := f.addressCodec.BytesToString([]byte("adminAddr___________________"))
+	require.NoError(t, err)
+	member, err := f.addressCodec.BytesToString([]byte("memberAddr__________________"))
+	require.NoError(t, err)
+	outsider, err := f.addressCodec.BytesToString([]byte("outsiderAddr________________"))
+	require.NoError(t, err)
+
+	_, err = srv.CreateItem(f.ctx, &types.MsgCreateItem{Creator: admin, Namespace: "logistics"})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	_, err = srv.CreateCollection(f.ctx, &types.MsgCreateCollection{
+		Creator:            admin,
+		Namespace:          "logistics",
+		CreationPolicy:     types.CREATION_POLICY_ALLOWLIST,
+		Allowlist:          []string{member},
+		MaxItems:           3,
+		MaxItemsPerCreator: 2,
+	})
+	require.NoError(t, err)
+
+	_, err = srv.CreateItem(f.ctx, &types.MsgCreateItem{Creator: outsider, Namespace: "logistics"})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	for i := 0; i < 2; i++ {
+		_, err = srv.CreateItem(f.ctx, &types.MsgCreateItem{Creator: member, Namespace: "logistics"})
+		require.NoError(t, err)
+	}
+	_, err = srv.CreateItem(f.ctx, &types.MsgCreateItem{Creator: member, Namespace: "logistics"})
+	require.ErrorIs(t, err, types.ErrCollectionFull)
+
+	resp, err := srv.CreateItem(f.ctx, &types.MsgCreateItem{Creator: admin, Namespace: "logistics"})
+	require.NoError(t, err)
+	_, err = srv.CreateItem(f.ctx, &types.MsgCreateItem{Creator: admin, Namespace: "logistics"})
+	require.ErrorIs(t, err, types.ErrCollectionFull)
+
+	found, err := qs.GetCollection(f.ctx, &types.QueryGetCollectionRequest{Namespace: "logistics"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), found.ItemCount)
+
+	// Deleting an item frees its slot
+	_, err = srv.DeleteItem(f.ctx, &types.MsgDeleteItem{Creator: admin, Id: resp.Id})
+	require.NoError(t, err)
+	_, err = srv.CreateItem(f.ctx, &types.MsgCreateItem{Creator: admin, Namespace: "logistics"})
+	require.NoError(t, err)
+
+	// Only the admin can update the collection, and may hand it over
+	_, err = srv.UpdateCollection(f.ctx, &types.MsgUpdateCollection{Creator: member, Namespace: "logistics", CreationPolicy: types.CREATION_POLICY_OPEN})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = srv.UpdateCollection(f.ctx, &types.MsgUpdateCollection{Creator: admin, Namespace: "logistics", NewAdmin: member, CreationPolicy: types.CREATION_POLICY_ADMIN_ONLY})
+	require.NoError(t, err)
+
+	_, err = srv.CreateItem(f.ctx, &types.MsgCreateItem{Creator: admin, Namespace: "logistics"})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.CreateItem(f.ctx, &types.MsgCreateItem{Creator: member, Namespace: "logistics"})
+	require.NoError(t, err)
+
+	_, err = srv.SetAttributeSchema(f.ctx, &types.MsgSetAttributeSchema{Creator: admin, Namespace: "logistics"})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"omnis/x/omnis/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) SetItemExpiry(ctx context.Context, msg *types.MsgSetItemExpiry) (*types.MsgSetItemExpiryResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	if err := validateExpiry(ctx, msg.ExpiresAt); err != nil {
+		return nil, err
+	}
+
+	item, err := k.getOwnedItem(ctx, msg.Id, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if item.Expired {
+		return nil, errorsmod.Wrapf(types.ErrItemExpired, "item %d expired", item.Id)
+	}
+
+	prev := item
+	item.ExpiresAt = msg.ExpiresAt
+	if err := k.recordItemRevision(ctx, prev, &item, msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to record item revision")
+	}
+	if err := k.SetItem(ctx, item); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update item")
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventItemExpirySet{
+		Id:        item.Id,
+		ExpiresAt: item.ExpiresAt,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetItemExpiryResponse{}, nil
+}
+
+// validateExpiry checks that an optional expiry time is in the future.
+func validateExpiry(ctx context.Context, expiresAt *time.Time) error {
+	if expiresAt == nil {
+		return nil
+	}
+	if blockTime := sdk.UnwrapSDKContext(ctx).BlockTime(); !expiresAt.After(blockTime) {
+		return errorsmod.Wrapf(types.ErrInvalidExpiry, "expiry %s is not after the block time %s", expiresAt, blockTime)
+	}
+	return nil
+}
@@ -0,0 +1,302 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"omnis/x/omnis/types"
+	tokentypes "omnis/x/token/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (k msgServer) FractionalizeItem(ctx context.Context, msg *types.MsgFractionalizeItem) (*types.MsgFractionalizeItemResponse, error) {
+	owner, err := k.addressCodec.StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+	if k.tokenKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "fractionalization is not enabled")
+	}
+	if msg.Supply.IsNil() || !msg.Supply.IsPositive() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "fraction supply must be positive")
+	}
+
+	// Only the owner may fractionalize an item, since the tokens are issued
+	// to the sender
+	item, err := k.getOwnedItem(ctx, msg.Id, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if item.Expired {
+		return nil, errorsmod.Wrapf(types.ErrItemExpired, "item %d expired", item.Id)
+	}
+	if item.ExpiresAt != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "item %d has an expiry time and cannot be fractionalized", item.Id)
+	}
+	// The fraction of an item bought out is kept until its proceeds are claimed
+	found, err := k.Fractions.Has(ctx, item.Id)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get fraction")
+	}
+	if found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "the proceeds of the buyout of item %d have yet to be claimed", item.Id)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get params")
+	}
+	if err := params.ValidateListingPrice(msg.ReservePrice); err != nil {
+		return nil, err
+	}
+
+	escrow, err := k.addressCodec.BytesToString(authtypes.NewModuleAddress(types.ModuleName))
+	if err != nil {
+		return nil, err
+	}
+	if err := k.transferItem(ctx, item, escrow, msg.Creator, types.CUSTODY_REASON_FRACTIONALIZED, ""); err != nil {
+		return nil, err
+	}
+
+	metadata, err := json.Marshal(map[string]string{
+		"omnis_item_id": strconv.FormatUint(item.Id, 10),
+		"namespace":     item.Namespace,
+	})
+	if err != nil {
+		return nil, err
+	}
+	// The token is issued by the module, so that no account can mint more or
+	// burn any outside of redemptions and claims
+	token, err := k.tokenKeeper.IssueToken(ctx, types.ModuleName, tokentypes.Token{
+		Name:        msg.Name,
+		Symbol:      msg.Symbol,
+		TotalSupply: msg.Supply.String(),
+		Metadata:    string(metadata),
+	}, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	fraction := types.Fraction{
+		ItemId:       item.Id,
+		TokenId:      token.Id,
+		Denom:        token.Symbol,
+		Supply:       msg.Supply,
+		Outstanding:  msg.Supply,
+		Owner:        msg.Creator,
+		ReservePrice: msg.ReservePrice,
+		Proceeds:     sdk.NewCoin(msg.ReservePrice.Denom, math.ZeroInt()),
+	}
+	if err := k.Fractions.Set(ctx, item.Id, fraction); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set fraction")
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventItemFractionalized{
+		Id:           item.Id,
+		Owner:        msg.Creator,
+		TokenId:      token.Id,
+		Supply:       sdk.NewCoin(fraction.Denom, fraction.Supply),
+		ReservePrice: fraction.ReservePrice,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFractionalizeItemResponse{TokenId: token.Id}, nil
+}
+
+func (k msgServer) RedeemFraction(ctx context.Context, msg *types.MsgRedeemFraction) (*types.MsgRedeemFractionResponse, error) {
+	redeemer, err := k.addressCodec.StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+	if k.tokenKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "fractionalization is not enabled")
+	}
+
+	fraction, err := k.getFraction(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	if fraction.IsBoughtOut() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "item %d was bought out, its proceeds can be claimed instead", fraction.ItemId)
+	}
+
+	balance := k.bankKeeper.SpendableCoins(ctx, redeemer).AmountOf(fraction.Denom)
+	if balance.GT(fraction.Outstanding) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrLogic, "balance of %s exceeds its outstanding supply", fraction.Denom)
+	}
+	if balance.LT(fraction.Outstanding) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "the whole supply of %s is required to redeem item %d", fraction.Denom, fraction.ItemId)
+	}
+	if err := k.tokenKeeper.BurnTokenFrom(ctx, fraction.TokenId, redeemer, balance); err != nil {
+		return nil, err
+	}
+
+	item, err := k.getExistingItem(ctx, fraction.ItemId)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.transferItem(ctx, item, msg.Creator, msg.Creator, types.CUSTODY_REASON_REDEEMED, ""); err != nil {
+		return nil, err
+	}
+	if err := k.Fractions.Remove(ctx, fraction.ItemId); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to delete fraction")
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventFractionRedeemed{
+		Id:       fraction.ItemId,
+		Redeemer: msg.Creator,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRedeemFractionResponse{}, nil
+}
+
+func (k msgServer) BuyoutItem(ctx context.Context, msg *types.MsgBuyoutItem) (*types.MsgBuyoutItemResponse, error) {
+	buyer, err := k.addressCodec.StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	fraction, err := k.getFraction(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	if fraction.IsBoughtOut() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "item %d was already bought out", fraction.ItemId)
+	}
+	if !msg.Price.Equal(fraction.ReservePrice) {
+		return nil, errorsmod.Wrapf(types.ErrPriceMismatch, "the reserve price of item %d is %s", fraction.ItemId, fraction.ReservePrice)
+	}
+
+	item, err := k.getExistingItem(ctx, fraction.ItemId)
+	if err != nil {
+		return nil, err
+	}
+
+	// The royalty is honored as on marketplace sales, the rest of the price is
+	// escrowed for the holders
+	royalty, royaltyRecipient := royaltyOf(item, fraction.ReservePrice, sdk.NewCoin(fraction.ReservePrice.Denom, math.ZeroInt()))
+	if royalty.IsPositive() {
+		recipient, err := k.addressCodec.StringToBytes(royaltyRecipient)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid royalty recipient address: %s", err))
+		}
+		if err := k.bankKeeper.SendCoins(ctx, buyer, recipient, sdk.NewCoins(royalty)); err != nil {
+			return nil, err
+		}
+	}
+	proceeds := fraction.ReservePrice.Sub(royalty)
+	if proceeds.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, buyer, types.ModuleName, sdk.NewCoins(proceeds)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.transferItem(ctx, item, msg.Creator, msg.Creator, types.CUSTODY_REASON_BUYOUT, ""); err != nil {
+		return nil, err
+	}
+
+	fraction.Buyer = msg.Creator
+	fraction.Proceeds = proceeds
+	if err := k.Fractions.Set(ctx, fraction.ItemId, fraction); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set fraction")
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventItemBoughtOut{
+		Id:      fraction.ItemId,
+		Buyer:   msg.Creator,
+		Price:   fraction.ReservePrice,
+		Royalty: royalty,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBuyoutItemResponse{}, nil
+}
+
+func (k msgServer) ClaimBuyoutProceeds(ctx context.Context, msg *types.MsgClaimBuyoutProceeds) (*types.MsgClaimBuyoutProceedsResponse, error) {
+	holder, err := k.addressCodec.StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+	if k.tokenKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "fractionalization is not enabled")
+	}
+
+	fraction, err := k.getFraction(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !fraction.IsBoughtOut() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "item %d was not bought out", fraction.ItemId)
+	}
+
+	balance := k.bankKeeper.SpendableCoins(ctx, holder).AmountOf(fraction.Denom)
+	if !balance.IsPositive() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "no %s to claim the proceeds of item %d with", fraction.Denom, fraction.ItemId)
+	}
+	if balance.GT(fraction.Outstanding) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrLogic, "balance of %s exceeds its outstanding supply", fraction.Denom)
+	}
+
+	// The share is rounded down, the last holder to claim gets the remainder
+	share := sdk.NewCoin(fraction.Proceeds.Denom, fraction.Proceeds.Amount.Mul(balance).Quo(fraction.Outstanding))
+	if balance.Equal(fraction.Outstanding) {
+		share = fraction.Proceeds
+	}
+
+	if err := k.tokenKeeper.BurnTokenFrom(ctx, fraction.TokenId, holder, balance); err != nil {
+		return nil, err
+	}
+	if share.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, holder, sdk.NewCoins(share)); err != nil {
+			return nil, err
+		}
+	}
+
+	fraction.Proceeds = fraction.Proceeds.Sub(share)
+	fraction.Outstanding = fraction.Outstanding.Sub(balance)
+	if fraction.Outstanding.IsZero() {
+		err = k.Fractions.Remove(ctx, fraction.ItemId)
+	} else {
+		err = k.Fractions.Set(ctx, fraction.ItemId, fraction)
+	}
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update fraction")
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventBuyoutProceedsClaimed{
+		Id:       fraction.ItemId,
+		Holder:   msg.Creator,
+		Burnt:    sdk.NewCoin(fraction.Denom, balance),
+		Proceeds: share,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimBuyoutProceedsResponse{Proceeds: share}, nil
+}
+
+// getFraction returns the fraction of an item, or ErrKeyNotFound if the item
+// is not fractionalized.
+func (k Keeper) getFraction(ctx context.Context, id uint64) (types.Fraction, error) {
+	fraction, err := k.Fractions.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.Fraction{}, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "item %d is not fractionalized", id)
+		}
+		return types.Fraction{}, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get fraction")
+	}
+	return fraction, nil
+}
@@ -0,0 +1,176 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"omnis/x/omnis/keeper"
+	"omnis/x/omnis/types"
+)
+
+func TestFractionalizeItem(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	owner, err := f.addressCodec.BytesToString([]byte("ownerAddr___________________"))
+	require.NoError(t, err)
+	other, err := f.addressCodec.BytesToString([]byte("otherAddr___________________"))
+	require.NoError(t, err)
+	escrow, err := f.addressCodec.BytesToString(authtypes.NewModuleAddress(types.ModuleName))
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.MarketplaceDenoms = []string{"oms"}
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	namespace := createOpenCollection(t, f, owner)
+	resp, err := srv.CreateItem(f.ctx, &types.MsgCreateItem{Creator: owner, Namespace: namespace})
+	require.NoError(t, err)
+
+	fractionalize := func(creator string, id uint64, supply int64, reserve sdk.Coin) *types.MsgFractionalizeItem {
+		return types.NewMsgFractionalizeItem(creator, id, "Fraction", "frac", math.NewInt(supply), reserve)
+	}
+	tests := []struct {
+		desc    string
+		request *types.MsgFractionalizeItem
+		err     error
+	}{
+		{
+			desc:    "zero supply",
+			request: fractionalize(owner, resp.Id, 0, sdk.NewInt64Coin("oms", 1000)),
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "denom not accepted",
+			request: fractionalize(owner, resp.Id, 100, sdk.NewInt64Coin("atom", 1000)),
+			err:     types.ErrInvalidPrice,
+		},
+		{
+			desc:    "not the owner",
+			request: fractionalize(other, resp.Id, 100, sdk.NewInt64Coin("oms", 1000)),
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "key not found",
+			request: fractionalize(owner, 10, 100, sdk.NewInt64Coin("oms", 1000)),
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "completed",
+			request: fractionalize(owner, resp.Id, 100, sdk.NewInt64Coin("oms", 1000)),
+		},
+		{
+			desc:    "already fractionalized",
+			request: fractionalize(owner, resp.Id, 100, sdk.NewInt64Coin("oms", 1000)),
+			err:     sdkerrors.ErrUnauthorized,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.FractionalizeItem(f.ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	// The item is escrowed and its tokens held by the owner
+	item, err := f.keeper.GetItem(f.ctx, resp.Id)
+	require.NoError(t, err)
+	require.Equal(t, escrow, item.Owner)
+	require.Equal(t, math.NewInt(100), f.bankKeeper.balances[owner].AmountOf("frac"))
+	got, err := qs.GetFraction(f.ctx, &types.QueryGetFractionRequest{Id: resp.Id})
+	require.NoError(t, err)
+	require.Equal(t, "frac", got.Fraction.Denom)
+	require.Equal(t, owner, got.Fraction.Owner)
+
+	// The whole supply is required to redeem the item
+	ownerAddr := sdk.MustAccAddressFromBech32(owner)
+	otherAddr := sdk.MustAccAddressFromBech32(other)
+	require.NoError(t, f.bankKeeper.SendCoins(f.ctx, ownerAddr, otherAddr, sdk.NewCoins(sdk.NewInt64Coin("frac", 1))))
+	_, err = srv.RedeemFraction(f.ctx, types.NewMsgRedeemFraction(owner, resp.Id))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.NoError(t, f.bankKeeper.SendCoins(f.ctx, otherAddr, ownerAddr, sdk.NewCoins(sdk.NewInt64Coin("frac", 1))))
+	_, err = srv.RedeemFraction(f.ctx, types.NewMsgRedeemFraction(owner, resp.Id))
+	require.NoError(t, err)
+
+	item, err = f.keeper.GetItem(f.ctx, resp.Id)
+	require.NoError(t, err)
+	require.Equal(t, owner, item.Owner)
+	require.True(t, f.bankKeeper.balances[owner].AmountOf("frac").IsZero())
+	_, err = qs.GetFraction(f.ctx, &types.QueryGetFractionRequest{Id: resp.Id})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+}
+
+func TestBuyoutItem(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	owner, err := f.addressCodec.BytesToString([]byte("ownerAddr___________________"))
+	require.NoError(t, err)
+	holder, err := f.addressCodec.BytesToString([]byte("holderAddr__________________"))
+	require.NoError(t, err)
+	buyer, err := f.addressCodec.BytesToString([]byte("buyerAddr___________________"))
+	require.NoError(t, err)
+	ownerAddr := sdk.MustAccAddressFromBech32(owner)
+	holderAddr := sdk.MustAccAddressFromBech32(holder)
+
+	params := types.DefaultParams()
+	params.MarketplaceDenoms = []string{"oms"}
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	f.bankKeeper.balances[buyer] = sdk.NewCoins(sdk.NewInt64Coin("oms", 1000))
+
+	namespace := createOpenCollection(t, f, owner)
+	resp, err := srv.CreateItem(f.ctx, &types.MsgCreateItem{Creator: owner, Namespace: namespace})
+	require.NoError(t, err)
+	_, err = srv.FractionalizeItem(f.ctx, types.NewMsgFractionalizeItem(owner, resp.Id, "Fraction", "frac", math.NewInt(3), sdk.NewInt64Coin("oms", 1000)))
+	require.NoError(t, err)
+	require.NoError(t, f.bankKeeper.SendCoins(f.ctx, ownerAddr, holderAddr, sdk.NewCoins(sdk.NewInt64Coin("frac", 1))))
+
+	_, err = srv.ClaimBuyoutProceeds(f.ctx, types.NewMsgClaimBuyoutProceeds(holder, resp.Id))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = srv.BuyoutItem(f.ctx, types.NewMsgBuyoutItem(buyer, resp.Id, sdk.NewInt64Coin("oms", 999)))
+	require.ErrorIs(t, err, types.ErrPriceMismatch)
+	_, err = srv.BuyoutItem(f.ctx, types.NewMsgBuyoutItem(buyer, resp.Id, sdk.NewInt64Coin("oms", 1000)))
+	require.NoError(t, err)
+	_, err = srv.BuyoutItem(f.ctx, types.NewMsgBuyoutItem(buyer, resp.Id, sdk.NewInt64Coin("oms", 1000)))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	item, err := f.keeper.GetItem(f.ctx, resp.Id)
+	require.NoError(t, err)
+	require.Equal(t, buyer, item.Owner)
+	_, err = srv.RedeemFraction(f.ctx, types.NewMsgRedeemFraction(owner, resp.Id))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// Shares are computed against the outstanding supply, not the bank supply
+	f.bankKeeper.balances[buyer] = f.bankKeeper.balances[buyer].Add(sdk.NewInt64Coin("frac", 5))
+	_, err = srv.ClaimBuyoutProceeds(f.ctx, types.NewMsgClaimBuyoutProceeds(buyer, resp.Id))
+	require.ErrorIs(t, err, sdkerrors.ErrLogic)
+
+	// The proceeds are shared pro rata, the last holder gets the remainder
+	claimed, err := srv.ClaimBuyoutProceeds(f.ctx, types.NewMsgClaimBuyoutProceeds(holder, resp.Id))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("oms", 333), claimed.Proceeds)
+	fraction, err := f.keeper.Fractions.Get(f.ctx, resp.Id)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(2), fraction.Outstanding)
+	_, err = srv.ClaimBuyoutProceeds(f.ctx, types.NewMsgClaimBuyoutProceeds(holder, resp.Id))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+	claimed, err = srv.ClaimBuyoutProceeds(f.ctx, types.NewMsgClaimBuyoutProceeds(owner, resp.Id))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("oms", 667), claimed.Proceeds)
+
+	require.Equal(t, math.NewInt(333), f.bankKeeper.balances[holder].AmountOf("oms"))
+	require.Equal(t, math.NewInt(667), f.bankKeeper.balances[owner].AmountOf("oms"))
+	found, err := f.keeper.Fractions.Has(f.ctx, resp.Id)
+	require.NoError(t, err)
+	require.False(t, found)
+}
@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"omnis/x/omnis/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+)
+
+func (k msgServer) IBCTransferItems(ctx context.Context, msg *types.MsgIBCTransferItems) (*types.MsgIBCTransferItemsResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+	if strings.TrimSpace(msg.Receiver) == "" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "missing receiver address")
+	}
+	if len(msg.Ids) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no items to transfer")
+	}
+	if msg.TimeoutTimestamp == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "timeout timestamp must be set")
+	}
+
+	ics4Wrapper := k.ics4Wrapper()
+	if ics4Wrapper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "IBC is not enabled")
+	}
+	if _, err := k.getCollection(ctx, msg.Namespace); err != nil {
+		return nil, err
+	}
+	classID, err := k.classIDOf(ctx, msg.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	// Items heading back to the chain their class comes from are burnt, the
+	// others are escrowed until they come back
+	burn := types.ReceiverChainIsSource(msg.SourcePort, msg.SourceChannel, classID)
+	escrow, err := k.addressCodec.BytesToString(types.GetEscrowAddress(msg.SourcePort, msg.SourceChannel))
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[uint64]bool, len(msg.Ids))
+	for _, id := range msg.Ids {
+		if seen[id] {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicated item %d", id)
+		}
+		seen[id] = true
+	}
+
+	tokenIDs := make([]string, 0, len(msg.Ids))
+	tokenURIs := make([]string, 0, len(msg.Ids))
+	hasURIs := false
+	for _, id := range msg.Ids {
+		// Only the owner may send an item, since refunds go to the sender
+		item, err := k.getOwnedItem(ctx, id, msg.Creator)
+		if err != nil {
+			return nil, err
+		}
+		if item.Namespace != msg.Namespace {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "item %d is not in collection %s", item.Id, msg.Namespace)
+		}
+		if item.Expired {
+			return nil, errorsmod.Wrapf(types.ErrItemExpired, "item %d expired", item.Id)
+		}
+		if item.ExpiresAt != nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "item %d has an expiry time and cannot leave the chain", item.Id)
+		}
+
+		tokenID, err := k.tokenIDOf(ctx, item)
+		if err != nil {
+			return nil, err
+		}
+		tokenIDs = append(tokenIDs, tokenID)
+		tokenURIs = append(tokenURIs, item.Uri)
+		hasURIs = hasURIs || item.Uri != ""
+
+		if burn {
+			err = k.burnVoucher(ctx, item)
+		} else {
+			err = k.escrowItem(ctx, item, escrow)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !hasURIs {
+		tokenURIs = nil
+	}
+
+	data := types.NewNonFungibleTokenPacketData(classID, tokenIDs, tokenURIs, msg.Creator, msg.Receiver, msg.Memo)
+	if err := data.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sequence, err := ics4Wrapper.SendPacket(sdkCtx, msg.SourcePort, msg.SourceChannel, clienttypes.ZeroHeight(), msg.TimeoutTimestamp, data.GetBytes())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventItemsSentIBC{
+		Sender:        msg.Creator,
+		Receiver:      msg.Receiver,
+		SourceChannel: msg.SourceChannel,
+		Sequence:      sequence,
+		ClassId:       classID,
+		TokenIds:      tokenIDs,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgIBCTransferItemsResponse{Sequence: sequence}, nil
+}
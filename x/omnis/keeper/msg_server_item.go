@@ -0,0 +1,354 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"omnis/x/omnis/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) CreateItem(ctx context.Context, msg *types.MsgCreateItem) (*types.MsgCreateItemResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	id, err := k.createItem(ctx, types.Item{
+		Name:       msg.Name,
+		Owner:      msg.Creator,
+		Creator:    msg.Creator,
+		Alias:      msg.Alias,
+		Namespace:  msg.Namespace,
+		Attributes: msg.Attributes,
+		ExpiresAt:  msg.ExpiresAt,
+		Royalty:    msg.Royalty,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateItemResponse{
+		Id: id,
+	}, nil
+}
+
+func (k msgServer) UpdateItem(ctx context.Context, msg *types.MsgUpdateItem) (*types.MsgUpdateItemResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	item, err := k.getUsableItem(ctx, msg.Id, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	prev := item
+	item.Name = msg.NewName
+	if err := k.recordItemRevision(ctx, prev, &item, msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to record item revision")
+	}
+	if err := k.SetItem(ctx, item); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update item")
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventItemUpdated{
+		Id:    item.Id,
+		Name:  item.Name,
+		Owner: item.Owner,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateItemResponse{}, nil
+}
+
+func (k msgServer) DeleteItem(ctx context.Context, msg *types.MsgDeleteItem) (*types.MsgDeleteItemResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	item, err := k.getEditableItem(ctx, msg.Id, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.removeItem(ctx, item, types.CUSTODY_REASON_DELETED); err != nil {
+		return nil, err
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventItemDeleted{
+		Id:    item.Id,
+		Owner: item.Owner,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeleteItemResponse{}, nil
+}
+
+func (k msgServer) TransferItem(ctx context.Context, msg *types.MsgTransferItem) (*types.MsgTransferItemResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+	if _, err := k.addressCodec.StringToBytes(msg.NewOwner); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid new owner address: %s", err))
+	}
+	if err := types.ValidateCustodyMemo(msg.Memo); err != nil {
+		return nil, err
+	}
+
+	item, err := k.getEditableItem(ctx, msg.Id, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if msg.NewOwner == item.Owner {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "new owner is already the owner")
+	}
+	if item.Expired {
+		return nil, errorsmod.Wrapf(types.ErrItemExpired, "item %d expired", item.Id)
+	}
+
+	if err := k.transferItem(ctx, item, msg.NewOwner, msg.Creator, types.CUSTODY_REASON_TRANSFER, msg.Memo); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTransferItemResponse{}, nil
+}
+
+// transferItem hands an item over to a new owner and records the change in its
+// chain of custody. The approvals, the listing and the rental of the item,
+// which were made by the previous owner, are cleared.
+func (k Keeper) transferItem(ctx context.Context, item types.Item, newOwner string, editor string, reason types.CustodyReason, memo string) error {
+	prev := item
+	item.Owner = newOwner
+	item.User, item.UserExpiresAt = "", nil
+	if err := k.recordItemRevision(ctx, prev, &item, editor); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to record item revision")
+	}
+	if err := k.SetItem(ctx, item); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update item")
+	}
+	if err := k.transferItemNFT(ctx, item); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to transfer item nft")
+	}
+	if err := k.recordCustody(ctx, item.Id, prev.Owner, newOwner, reason, memo); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to record custody")
+	}
+
+	if err := k.clearItemApprovals(ctx, item.Id); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to clear item approvals")
+	}
+	if err := k.removeListing(ctx, item.Id); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to delete listing")
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventItemTransferred{
+		Id:     item.Id,
+		From:   prev.Owner,
+		To:     newOwner,
+		Reason: reason,
+		Memo:   memo,
+	})
+}
+
+// createItem assigns the next id to a new item owned by its creator and stores
+// it in its collection, enforcing the collection policy and limits.
+func (k Keeper) createItem(ctx context.Context, item types.Item) (uint64, error) {
+	creatorAddr, err := k.addressCodec.StringToBytes(item.Creator)
+	if err != nil {
+		return 0, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	collection, err := k.getCollection(ctx, item.Namespace)
+	if err != nil {
+		return 0, err
+	}
+	if !collection.CanCreateItems(item.Creator) {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "not allowed to create items in collection %s", item.Namespace)
+	}
+
+	if item.Alias != "" {
+		if err := types.ValidateItemAlias(item.Alias); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := validateExpiry(ctx, item.ExpiresAt); err != nil {
+		return 0, err
+	}
+
+	// The royalty is fixed at creation, later changes of the collection
+	// royalty do not apply to the item
+	if item.Royalty == nil {
+		item.Royalty = collection.Royalty
+	} else if err := item.Royalty.Validate(); err != nil {
+		return 0, err
+	}
+
+	item.Attributes, err = k.validateNewItemAttributes(ctx, item.Namespace, item.Attributes)
+	if err != nil {
+		return 0, err
+	}
+
+	lifecycle, err := k.GetItemLifecycle(ctx, item.Namespace)
+	if err != nil {
+		return 0, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get item lifecycle")
+	}
+	if lifecycle != nil {
+		item.State = lifecycle.InitialState
+	}
+
+	if err := k.addCollectionItem(ctx, collection, creatorAddr); err != nil {
+		return 0, err
+	}
+
+	item.Id, err = k.ItemSeq.Next(ctx)
+	if err != nil {
+		return 0, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "failed to get next id")
+	}
+
+	if err := k.recordItemRevision(ctx, types.Item{}, &item, item.Creator); err != nil {
+		return 0, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to record item revision")
+	}
+	if err := k.SetItem(ctx, item); err != nil {
+		return 0, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set item")
+	}
+
+	if item.Alias != "" {
+		if err := k.setItemAlias(ctx, item); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := k.mintItemNFT(ctx, item); err != nil {
+		return 0, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to mint item nft")
+	}
+	if err := k.recordCustody(ctx, item.Id, "", item.Owner, types.CUSTODY_REASON_CREATED, ""); err != nil {
+		return 0, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to record custody")
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventItemCreated{
+		Id:    item.Id,
+		Name:  item.Name,
+		Owner: item.Owner,
+		Alias: item.Alias,
+	}); err != nil {
+		return 0, err
+	}
+
+	return item.Id, nil
+}
+
+// removeItem deletes an item and releases its alias, its slot in its
+// collection, its approvals and its listing. Its NFT, if any, is burnt. Its
+// chain of custody is kept and ends with the deletion.
+func (k Keeper) removeItem(ctx context.Context, item types.Item, reason types.CustodyReason) error {
+	if err := k.DeleteItem(ctx, item.Id); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to delete item")
+	}
+
+	if item.Alias != "" {
+		if err := k.removeItemAlias(ctx, item); err != nil {
+			return err
+		}
+	}
+
+	if err := k.removeCollectionItem(ctx, item); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update collection item count")
+	}
+
+	if err := k.clearItemApprovals(ctx, item.Id); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to clear item approvals")
+	}
+
+	if err := k.removeListing(ctx, item.Id); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to delete listing")
+	}
+
+	if types.IsVoucherNamespace(item.Namespace) {
+		if err := k.VoucherTokens.Remove(ctx, item.Id); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to delete voucher token")
+		}
+	}
+
+	if err := k.clearItemAttestations(ctx, item.Id); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to clear item attestations")
+	}
+
+	if err := k.burnItemNFT(ctx, item); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to burn item nft")
+	}
+
+	if err := k.recordCustody(ctx, item.Id, item.Owner, "", reason, ""); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to record custody")
+	}
+	return nil
+}
+
+// getExistingItem returns the item with the given id, or ErrKeyNotFound.
+func (k Keeper) getExistingItem(ctx context.Context, id uint64) (types.Item, error) {
+	item, err := k.GetItem(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.Item{}, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", id))
+		}
+
+		return types.Item{}, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get item")
+	}
+	return item, nil
+}
+
+// getOwnedItem returns the item with the given id after checking that it is
+// owned by owner.
+func (k Keeper) getOwnedItem(ctx context.Context, id uint64, owner string) (types.Item, error) {
+	item, err := k.getExistingItem(ctx, id)
+	if err != nil {
+		return types.Item{}, err
+	}
+
+	if item.Owner != owner {
+		return types.Item{}, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	}
+
+	return item, nil
+}
+
+// setItemAlias reserves the alias of an item in the scope of its creator.
+func (k Keeper) setItemAlias(ctx context.Context, item types.Item) error {
+	creator, err := k.addressCodec.StringToBytes(item.Creator)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	key := collections.Join(sdk.AccAddress(creator), item.Alias)
+	taken, err := k.ItemAlias.Has(ctx, key)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get item alias")
+	}
+	if taken {
+		return errorsmod.Wrapf(types.ErrItemAlreadyExists, "item with alias %s already exists", item.Alias)
+	}
+
+	if err := k.ItemAlias.Set(ctx, key, item.Id); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set item alias")
+	}
+	return nil
+}
+
+// removeItemAlias releases the alias of an item.
+func (k Keeper) removeItemAlias(ctx context.Context, item types.Item) error {
+	creator, err := k.addressCodec.StringToBytes(item.Creator)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	if err := k.ItemAlias.Remove(ctx, collections.Join(sdk.AccAddress(creator), item.Alias)); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to delete item alias")
+	}
+	return nil
+}
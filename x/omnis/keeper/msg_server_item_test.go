@@ -0,0 +1,200 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"omnis/x/omnis/keeper"
+	"omnis/x/omnis/types"
+)
+
+func TestItemMsgServerCreate(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	namespace := createOpenCollection(t, f, creator)
+
+	for i := 0; i < 5; i++ {
+		resp, err := srv.CreateItem(f.ctx, &types.MsgCreateItem{Creator: creator, Namespace: namespace})
+		require.NoError(t, err)
+		require.Equal(t, i, int(resp.Id))
+	}
+
+	item, err := f.keeper.GetItem(f.ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, creator, item.Owner)
+	require.Equal(t, creator, item.Creator)
+}
+
+func TestItemMsgServerCreateAlias(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	namespace := createOpenCollection(t, f, creator)
+
+	otherCreator, err := f.addressCodec.BytesToString([]byte("otherSignerAddr_____________"))
+	require.NoError(t, err)
+
+	resp, err := srv.CreateItem(f.ctx, &types.MsgCreateItem{Creator: creator, Namespace: namespace, Name: "pallet", Alias: "pallet-42"})
+	require.NoError(t, err)
+
+	_, err = srv.CreateItem(f.ctx, &types.MsgCreateItem{Creator: creator, Namespace: namespace, Alias: "pallet-42"})
+	require.ErrorIs(t, err, types.ErrItemAlreadyExists)
+
+	_, err = srv.CreateItem(f.ctx, &types.MsgCreateItem{Creator: creator, Namespace: namespace, Alias: "not an alias"})
+	require.ErrorIs(t, err, types.ErrInvalidAlias)
+
+	// Aliases are scoped to their creator
+	otherResp, err := srv.CreateItem(f.ctx, &types.MsgCreateItem{Creator: otherCreator, Namespace: namespace, Alias: "pallet-42"})
+	require.NoError(t, err)
+
+	found, err := qs.GetItemByAlias(f.ctx, &types.QueryGetItemByAliasRequest{Creator: creator, Alias: "pallet-42"})
+	require.NoError(t, err)
+	require.Equal(t, resp.Id, found.Item.Id)
+
+	found, err = qs.GetItemByAlias(f.ctx, &types.QueryGetItemByAliasRequest{Creator: otherCreator, Alias: "pallet-42"})
+	require.NoError(t, err)
+	require.Equal(t, otherResp.Id, found.Item.Id)
+
+	// Deleting the item releases its alias
+	_, err = srv.DeleteItem(f.ctx, &types.MsgDeleteItem{Creator: creator, Id: resp.Id})
+	require.NoError(t, err)
+	_, err = qs.GetItemByAlias(f.ctx, &types.QueryGetItemByAliasRequest{Creator: creator, Alias: "pallet-42"})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	_, err = srv.CreateItem(f.ctx, &types.MsgCreateItem{Creator: creator, Namespace: namespace, Alias: "pallet-42"})
+	require.NoError(t, err)
+}
+
+func TestItemMsgServerDelete(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	namespace := createOpenCollection(t, f, creator)
+
+	unauthorizedAddr, err := f.addressCodec.BytesToString([]byte("unauthorizedAddr___________"))
+	require.NoError(t, err)
+
+	_, err = srv.CreateItem(f.ctx, &types.MsgCreateItem{Creator: creator, Namespace: namespace})
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc    string
+		request *types.MsgDeleteItem
+		err     error
+	}{
+		{
+			desc:    "invalid address",
+			request: &types.MsgDeleteItem{Creator: "invalid", Id: 0},
+			err:     sdkerrors.ErrInvalidAddress,
+		},
+		{
+			desc:    "unauthorized",
+			request: &types.MsgDeleteItem{Creator: unauthorizedAddr, Id: 0},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "key not found",
+			request: &types.MsgDeleteItem{Creator: creator, Id: 10},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "completed",
+			request: &types.MsgDeleteItem{Creator: creator, Id: 0},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err = srv.DeleteItem(f.ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				found, err := f.keeper.HasItem(f.ctx, tc.request.Id)
+				require.NoError(t, err)
+				require.False(t, found)
+			}
+		})
+	}
+}
+
+func TestItemMsgServerTransfer(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	namespace := createOpenCollection(t, f, creator)
+
+	newOwner, err := f.addressCodec.BytesToString([]byte("newOwnerAddr________________"))
+	require.NoError(t, err)
+
+	_, err = srv.CreateItem(f.ctx, &types.MsgCreateItem{Creator: creator, Namespace: namespace})
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc    string
+		request *types.MsgTransferItem
+		err     error
+	}{
+		{
+			desc:    "invalid address",
+			request: &types.MsgTransferItem{Creator: "invalid", Id: 0, NewOwner: newOwner},
+			err:     sdkerrors.ErrInvalidAddress,
+		},
+		{
+			desc:    "invalid new owner",
+			request: &types.MsgTransferItem{Creator: creator, Id: 0, NewOwner: "invalid"},
+			err:     sdkerrors.ErrInvalidAddress,
+		},
+		{
+			desc:    "self transfer",
+			request: &types.MsgTransferItem{Creator: creator, Id: 0, NewOwner: creator},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "unauthorized",
+			request: &types.MsgTransferItem{Creator: newOwner, Id: 0, NewOwner: creator},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "key not found",
+			request: &types.MsgTransferItem{Creator: creator, Id: 10, NewOwner: newOwner},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "completed",
+			request: &types.MsgTransferItem{Creator: creator, Id: 0, NewOwner: newOwner},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err = srv.TransferItem(f.ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	item, err := f.keeper.GetItem(f.ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, newOwner, item.Owner)
+
+	// The previous owner lost control over the item
+	_, err = srv.UpdateItem(f.ctx, &types.MsgUpdateItem{Creator: creator, Id: 0, NewName: "stolen"})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
@@ -0,0 +1,183 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"omnis/x/omnis/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) SetItemLifecycle(ctx context.Context, msg *types.MsgSetItemLifecycle) (*types.MsgSetItemLifecycleResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	collection, err := k.getAdministeredCollection(ctx, msg.Namespace, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	lifecycle := types.ItemLifecycle{
+		Namespace:    collection.Namespace,
+		States:       msg.States,
+		InitialState: msg.InitialState,
+		Transitions:  msg.Transitions,
+	}
+	if err := lifecycle.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Items already in the collection keep their state, so a replaced
+	// lifecycle cannot drop a state some of them are in. Items without a
+	// state enter the initial state on their first transition.
+	prev, err := k.GetItemLifecycle(ctx, collection.Namespace)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get item lifecycle")
+	}
+	if prev != nil {
+		for _, state := range prev.States {
+			if lifecycle.HasState(state) {
+				continue
+			}
+			inUse, err := k.hasItemsInState(ctx, collection.Namespace, state)
+			if err != nil {
+				return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get items by state")
+			}
+			if inUse {
+				return nil, errorsmod.Wrapf(types.ErrInvalidLifecycle, "items of collection %s are in the removed state %s", collection.Namespace, state)
+			}
+		}
+	}
+
+	if err := k.Lifecycles.Set(ctx, lifecycle.Namespace, lifecycle); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set item lifecycle")
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventItemLifecycleSet{
+		Namespace: lifecycle.Namespace,
+		Admin:     collection.Admin,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetItemLifecycleResponse{}, nil
+}
+
+func (k msgServer) TransitionItem(ctx context.Context, msg *types.MsgTransitionItem) (*types.MsgTransitionItemResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	item, err := k.getExistingItem(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	if item.Expired {
+		return nil, errorsmod.Wrapf(types.ErrItemExpired, "item %d expired", item.Id)
+	}
+
+	lifecycle, err := k.GetItemLifecycle(ctx, item.Namespace)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get item lifecycle")
+	}
+	if lifecycle == nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidTransition, "collection %s has no lifecycle", item.Namespace)
+	}
+	from := item.State
+	if from == "" {
+		from = lifecycle.InitialState
+	}
+	transition, found := lifecycle.Transition(from, msg.ToState)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrInvalidTransition, "no transition from %s to %s", from, msg.ToState)
+	}
+	allowed, err := k.canTriggerTransition(ctx, item, transition, msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to check transition roles")
+	}
+	if !allowed {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "not allowed to trigger transition %s -> %s", from, msg.ToState)
+	}
+
+	prev := item
+	item.State = msg.ToState
+	if err := k.recordItemRevision(ctx, prev, &item, msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to record item revision")
+	}
+	if err := k.SetItem(ctx, item); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update item")
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventItemTransitioned{
+		Id:    item.Id,
+		From:  from,
+		To:    item.State,
+		Actor: msg.Creator,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTransitionItemResponse{}, nil
+}
+
+// canTriggerTransition returns whether sender is one of the accounts of the
+// transition or holds one of its roles for the item.
+func (k Keeper) canTriggerTransition(ctx context.Context, item types.Item, transition types.LifecycleTransition, sender string) (bool, error) {
+	for _, account := range transition.Accounts {
+		if account == sender {
+			return true, nil
+		}
+	}
+
+	var collection *types.ItemCollection
+	for _, role := range transition.Roles {
+		switch role {
+		case types.TRANSITION_ROLE_OWNER:
+			if item.Owner == sender {
+				return true, nil
+			}
+		case types.TRANSITION_ROLE_OPERATOR:
+			if item.ActiveUser(sdk.UnwrapSDKContext(ctx).BlockTime()) == sender {
+				return true, nil
+			}
+			approved, err := k.isApprovedOperator(ctx, item, sender)
+			if err != nil {
+				return false, err
+			}
+			if approved {
+				return true, nil
+			}
+		case types.TRANSITION_ROLE_ADMIN, types.TRANSITION_ROLE_ATTESTER:
+			if collection == nil {
+				c, err := k.getCollection(ctx, item.Namespace)
+				if err != nil {
+					return false, err
+				}
+				collection = &c
+			}
+			if role == types.TRANSITION_ROLE_ADMIN && collection.Admin == sender {
+				return true, nil
+			}
+			if role == types.TRANSITION_ROLE_ATTESTER && collection.IsAttester(sender) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// hasItemsInState returns whether some item of the collection namespace is in
+// state.
+func (k Keeper) hasItemsInState(ctx context.Context, namespace, state string) (bool, error) {
+	iter, err := k.itemsByState.Iterate(ctx, collections.NewPrefixedPairRange[string, uint64](types.StateIndexKey(namespace, state)))
+	if err != nil {
+		return false, err
+	}
+	defer iter.Close()
+	return iter.Valid(), nil
+}
@@ -0,0 +1,161 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"omnis/x/omnis/keeper"
+	"omnis/x/omnis/types"
+)
+
+func TestItemLifecycle(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	admin, err := f.addressCodec.BytesToString([]byte("adminAddr___________________"))
+	require.NoError(t, err)
+	owner, err := f.addressCodec.BytesToString([]byte("ownerAddr___________________"))
+	require.NoError(t, err)
+	carrier, err := f.addressCodec.BytesToString([]byte("carrierAddr_________________"))
+	require.NoError(t, err)
+
+	namespace := createOpenCollection(t, f, admin)
+	// Items created before the lifecycle start from its initial state
+	before, err := srv.CreateItem(f.ctx, &types.MsgCreateItem{Creator: owner, Namespace: namespace})
+	require.NoError(t, err)
+
+	states := []string{"created", "shipped", "received", "closed"}
+	transitions := []types.LifecycleTransition{
+		{From: "created", To: "shipped", Roles: []types.TransitionRole{types.TRANSITION_ROLE_OWNER}},
+		{From: "shipped", To: "received", Accounts: []string{carrier}},
+		{From: "received", To: "closed", Roles: []types.TransitionRole{types.TRANSITION_ROLE_OWNER, types.TRANSITION_ROLE_ADMIN}},
+	}
+	lifecycleTests := []struct {
+		desc    string
+		request *types.MsgSetItemLifecycle
+		err     error
+	}{
+		{
+			desc:    "not the admin",
+			request: types.NewMsgSetItemLifecycle(owner, namespace, states, "created", transitions),
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "unknown initial state",
+			request: types.NewMsgSetItemLifecycle(admin, namespace, states, "draft", transitions),
+			err:     types.ErrInvalidLifecycle,
+		},
+		{
+			desc: "transition to an unknown state",
+			request: types.NewMsgSetItemLifecycle(admin, namespace, states, "created", []types.LifecycleTransition{
+				{From: "created", To: "lost", Roles: []types.TransitionRole{types.TRANSITION_ROLE_OWNER}},
+			}),
+			err: types.ErrInvalidLifecycle,
+		},
+		{
+			desc: "transition nobody may trigger",
+			request: types.NewMsgSetItemLifecycle(admin, namespace, states, "created", []types.LifecycleTransition{
+				{From: "created", To: "shipped"},
+			}),
+			err: types.ErrInvalidLifecycle,
+		},
+		{
+			desc:    "completed",
+			request: types.NewMsgSetItemLifecycle(admin, namespace, states, "created", transitions),
+		},
+	}
+	for _, tc := range lifecycleTests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.SetItemLifecycle(f.ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	created, err := srv.CreateItem(f.ctx, &types.MsgCreateItem{Creator: owner, Namespace: namespace})
+	require.NoError(t, err)
+	item, err := f.keeper.GetItem(f.ctx, created.Id)
+	require.NoError(t, err)
+	require.Equal(t, "created", item.State)
+
+	transitionTests := []struct {
+		desc    string
+		request *types.MsgTransitionItem
+		err     error
+	}{
+		{
+			desc:    "role not allowed",
+			request: types.NewMsgTransitionItem(carrier, created.Id, "shipped"),
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "no transition",
+			request: types.NewMsgTransitionItem(owner, created.Id, "received"),
+			err:     types.ErrInvalidTransition,
+		},
+		{
+			desc:    "key not found",
+			request: types.NewMsgTransitionItem(owner, 10, "shipped"),
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "owner ships",
+			request: types.NewMsgTransitionItem(owner, created.Id, "shipped"),
+		},
+		{
+			desc:    "account not allowed",
+			request: types.NewMsgTransitionItem(owner, created.Id, "received"),
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "carrier delivers",
+			request: types.NewMsgTransitionItem(carrier, created.Id, "received"),
+		},
+		{
+			desc:    "admin closes",
+			request: types.NewMsgTransitionItem(admin, created.Id, "closed"),
+		},
+		{
+			desc:    "final state",
+			request: types.NewMsgTransitionItem(admin, created.Id, "created"),
+			err:     types.ErrInvalidTransition,
+		},
+		{
+			desc:    "item created before the lifecycle",
+			request: types.NewMsgTransitionItem(owner, before.Id, "shipped"),
+		},
+	}
+	for _, tc := range transitionTests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.TransitionItem(f.ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	item, err = f.keeper.GetItem(f.ctx, created.Id)
+	require.NoError(t, err)
+	require.Equal(t, "closed", item.State)
+
+	closed, err := qs.ItemsByState(f.ctx, &types.QueryItemsByStateRequest{Namespace: namespace, State: "closed"})
+	require.NoError(t, err)
+	require.Len(t, closed.Items, 1)
+	require.Equal(t, created.Id, closed.Items[0].Id)
+	shipped, err := qs.ItemsByState(f.ctx, &types.QueryItemsByStateRequest{Namespace: namespace, State: "shipped"})
+	require.NoError(t, err)
+	require.Len(t, shipped.Items, 1)
+	require.Equal(t, before.Id, shipped.Items[0].Id)
+
+	// A state items are in cannot be removed from the lifecycle
+	_, err = srv.SetItemLifecycle(f.ctx, types.NewMsgSetItemLifecycle(admin, namespace, []string{"created", "shipped"}, "created", transitions[:1]))
+	require.ErrorIs(t, err, types.ErrInvalidLifecycle)
+}
@@ -0,0 +1,247 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"omnis/x/omnis/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (k msgServer) ListItem(ctx context.Context, msg *types.MsgListItem) (*types.MsgListItemResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	item, err := k.getEditableItem(ctx, msg.Id, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if item.Expired {
+		return nil, errorsmod.Wrapf(types.ErrItemExpired, "item %d expired", item.Id)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get params")
+	}
+	if err := params.ValidateListingPrice(msg.Price); err != nil {
+		return nil, err
+	}
+
+	listed, err := k.Listings.Has(ctx, item.Id)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get listing")
+	}
+	if listed {
+		return nil, errorsmod.Wrapf(types.ErrItemListed, "item %d is already listed", item.Id)
+	}
+
+	listing := types.Listing{
+		ItemId:    item.Id,
+		Seller:    item.Owner,
+		Namespace: item.Namespace,
+		Price:     msg.Price,
+	}
+	if err := k.Listings.Set(ctx, item.Id, listing); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set listing")
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventItemListed{
+		Id:     item.Id,
+		Seller: listing.Seller,
+		Price:  listing.Price,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgListItemResponse{}, nil
+}
+
+func (k msgServer) DelistItem(ctx context.Context, msg *types.MsgDelistItem) (*types.MsgDelistItemResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	item, err := k.getEditableItem(ctx, msg.Id, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	listing, err := k.getListing(ctx, item.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Listings.Remove(ctx, item.Id); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to delete listing")
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventItemDelisted{
+		Id:     item.Id,
+		Seller: listing.Seller,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDelistItemResponse{}, nil
+}
+
+func (k msgServer) UpdateListingPrice(ctx context.Context, msg *types.MsgUpdateListingPrice) (*types.MsgUpdateListingPriceResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	item, err := k.getEditableItem(ctx, msg.Id, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	listing, err := k.getListing(ctx, item.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get params")
+	}
+	if err := params.ValidateListingPrice(msg.Price); err != nil {
+		return nil, err
+	}
+
+	listing.Price = msg.Price
+	if err := k.Listings.Set(ctx, item.Id, listing); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set listing")
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventListingPriceUpdated{
+		Id:     item.Id,
+		Seller: listing.Seller,
+		Price:  listing.Price,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateListingPriceResponse{}, nil
+}
+
+func (k msgServer) BuyItem(ctx context.Context, msg *types.MsgBuyItem) (*types.MsgBuyItemResponse, error) {
+	buyer, err := k.addressCodec.StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	listing, err := k.getListing(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !msg.Price.Equal(listing.Price) {
+		return nil, errorsmod.Wrapf(types.ErrPriceMismatch, "item %d is listed at %s", listing.ItemId, listing.Price)
+	}
+	if listing.Seller == msg.Creator {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "cannot buy an item from oneself")
+	}
+	seller, err := k.addressCodec.StringToBytes(listing.Seller)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid seller address: %s", err))
+	}
+
+	item, err := k.getExistingItem(ctx, listing.ItemId)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get params")
+	}
+	// The denom may have been removed from the marketplace since the listing
+	if err := params.ValidateListingPrice(listing.Price); err != nil {
+		return nil, err
+	}
+	fee, err := params.MarketplaceFeeOf(listing.Price)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	royalty, royaltyRecipient := royaltyOf(item, listing.Price, fee)
+
+	if fee.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, buyer, authtypes.FeeCollectorName, sdk.NewCoins(fee)); err != nil {
+			return nil, err
+		}
+	}
+	if royalty.IsPositive() {
+		recipient, err := k.addressCodec.StringToBytes(royaltyRecipient)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid royalty recipient address: %s", err))
+		}
+		if err := k.bankKeeper.SendCoins(ctx, buyer, recipient, sdk.NewCoins(royalty)); err != nil {
+			return nil, err
+		}
+	}
+	if proceeds := listing.Price.Sub(fee).Sub(royalty); proceeds.IsPositive() {
+		if err := k.bankKeeper.SendCoins(ctx, buyer, seller, sdk.NewCoins(proceeds)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.transferItem(ctx, item, msg.Creator, msg.Creator, types.CUSTODY_REASON_SALE, ""); err != nil {
+		return nil, err
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventItemSold{
+		Id:               item.Id,
+		Seller:           listing.Seller,
+		Buyer:            msg.Creator,
+		Price:            listing.Price,
+		Fee:              fee,
+		Royalty:          royalty,
+		RoyaltyRecipient: royaltyRecipient,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBuyItemResponse{}, nil
+}
+
+// getListing returns the listing of an item, or ErrKeyNotFound if the item is
+// not listed.
+func (k Keeper) getListing(ctx context.Context, id uint64) (types.Listing, error) {
+	listing, err := k.Listings.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.Listing{}, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "item %d is not listed", id)
+		}
+		return types.Listing{}, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get listing")
+	}
+	return listing, nil
+}
+
+// royaltyOf returns the royalty owed on a sale of an item and its recipient.
+// The royalty is capped at the part of the price left after the marketplace
+// fee, and is zero with no recipient for items without a royalty.
+func royaltyOf(item types.Item, price sdk.Coin, fee sdk.Coin) (sdk.Coin, string) {
+	if item.Royalty == nil {
+		return sdk.NewCoin(price.Denom, math.ZeroInt()), ""
+	}
+	royalty := item.Royalty.RoyaltyOf(price)
+	if available := price.Sub(fee); available.IsLT(royalty) {
+		royalty = available
+	}
+	return royalty, item.Royalty.Recipient
+}
+
+// removeListing delists an item, if it is listed.
+func (k Keeper) removeListing(ctx context.Context, id uint64) error {
+	listed, err := k.Listings.Has(ctx, id)
+	if err != nil || !listed {
+		return err
+	}
+	return k.Listings.Remove(ctx, id)
+}
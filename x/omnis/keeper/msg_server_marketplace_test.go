@@ -0,0 +1,227 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"omnis/x/omnis/keeper"
+	"omnis/x/omnis/types"
+)
+
+func TestMarketplace(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	seller, err := f.addressCodec.BytesToString([]byte("sellerAddr__________________"))
+	require.NoError(t, err)
+	buyer, err := f.addressCodec.BytesToString([]byte("buyerAddr___________________"))
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.MarketplaceFee = "0.05"
+	params.MarketplaceDenoms = []string{sdk.DefaultBondDenom, "oms"}
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	namespace := createOpenCollection(t, f, seller)
+	resp, err := srv.CreateItem(f.ctx, &types.MsgCreateItem{Creator: seller, Namespace: namespace})
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc    string
+		request *types.MsgListItem
+		err     error
+	}{
+		{
+			desc:    "denom not accepted",
+			request: &types.MsgListItem{Creator: seller, Id: resp.Id, Price: sdk.NewInt64Coin("atom", 100)},
+			err:     types.ErrInvalidPrice,
+		},
+		{
+			desc:    "zero price",
+			request: &types.MsgListItem{Creator: seller, Id: resp.Id, Price: sdk.NewInt64Coin("oms", 0)},
+			err:     types.ErrInvalidPrice,
+		},
+		{
+			desc:    "not the owner",
+			request: &types.MsgListItem{Creator: buyer, Id: resp.Id, Price: sdk.NewInt64Coin("oms", 100)},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "key not found",
+			request: &types.MsgListItem{Creator: seller, Id: 10, Price: sdk.NewInt64Coin("oms", 100)},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "completed",
+			request: &types.MsgListItem{Creator: seller, Id: resp.Id, Price: sdk.NewInt64Coin("oms", 100)},
+		},
+		{
+			desc:    "already listed",
+			request: &types.MsgListItem{Creator: seller, Id: resp.Id, Price: sdk.NewInt64Coin("oms", 100)},
+			err:     types.ErrItemListed,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.ListItem(f.ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	byCollection, err := qs.ListingsByCollection(f.ctx, &types.QueryListingsByCollectionRequest{Namespace: namespace})
+	require.NoError(t, err)
+	require.Len(t, byCollection.Listings, 1)
+	bySeller, err := qs.ListingsBySeller(f.ctx, &types.QueryListingsBySellerRequest{Seller: seller})
+	require.NoError(t, err)
+	require.Len(t, bySeller.Listings, 1)
+
+	price := sdk.NewInt64Coin("oms", 200)
+	_, err = srv.UpdateListingPrice(f.ctx, &types.MsgUpdateListingPrice{Creator: seller, Id: resp.Id, Price: price})
+	require.NoError(t, err)
+
+	// The buyer is protected against price changes
+	_, err = srv.BuyItem(f.ctx, &types.MsgBuyItem{Creator: buyer, Id: resp.Id, Price: sdk.NewInt64Coin("oms", 100)})
+	require.ErrorIs(t, err, types.ErrPriceMismatch)
+	_, err = srv.BuyItem(f.ctx, &types.MsgBuyItem{Creator: seller, Id: resp.Id, Price: price})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = srv.BuyItem(f.ctx, &types.MsgBuyItem{Creator: buyer, Id: resp.Id, Price: price})
+	require.Error(t, err)
+
+	buyerAddr, err := f.addressCodec.StringToBytes(buyer)
+	require.NoError(t, err)
+	sellerAddr, err := f.addressCodec.StringToBytes(seller)
+	require.NoError(t, err)
+	f.bankKeeper.balances[sdk.AccAddress(buyerAddr).String()] = sdk.NewCoins(price)
+
+	_, err = srv.BuyItem(f.ctx, &types.MsgBuyItem{Creator: buyer, Id: resp.Id, Price: price})
+	require.NoError(t, err)
+
+	item, err := f.keeper.GetItem(f.ctx, resp.Id)
+	require.NoError(t, err)
+	require.Equal(t, buyer, item.Owner)
+	require.True(t, f.bankKeeper.balances[sdk.AccAddress(buyerAddr).String()].IsZero())
+	require.Equal(t, math.NewInt(190), f.bankKeeper.balances[sdk.AccAddress(sellerAddr).String()].AmountOf("oms"))
+	require.Equal(t, math.NewInt(10), f.bankKeeper.balances[authtypes.NewModuleAddress(authtypes.FeeCollectorName).String()].AmountOf("oms"))
+
+	// The sale closes the listing
+	_, err = qs.GetListing(f.ctx, &types.QueryGetListingRequest{Id: resp.Id})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	bySeller, err = qs.ListingsBySeller(f.ctx, &types.QueryListingsBySellerRequest{Seller: seller})
+	require.NoError(t, err)
+	require.Empty(t, bySeller.Listings)
+
+	// A transfer closes the listing as well
+	_, err = srv.ListItem(f.ctx, &types.MsgListItem{Creator: buyer, Id: resp.Id, Price: price})
+	require.NoError(t, err)
+	_, err = srv.TransferItem(f.ctx, &types.MsgTransferItem{Creator: buyer, Id: resp.Id, NewOwner: seller})
+	require.NoError(t, err)
+	_, err = srv.DelistItem(f.ctx, &types.MsgDelistItem{Creator: seller, Id: resp.Id})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+}
+
+func TestMarketplaceRoyalties(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	artist, err := f.addressCodec.BytesToString([]byte("artistAddr__________________"))
+	require.NoError(t, err)
+	seller, err := f.addressCodec.BytesToString([]byte("sellerAddr__________________"))
+	require.NoError(t, err)
+	buyer, err := f.addressCodec.BytesToString([]byte("buyerAddr___________________"))
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.MarketplaceFee = "0.05"
+	params.MarketplaceDenoms = []string{"oms"}
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	// Items inherit the royalty of their collection unless given their own
+	_, err = srv.CreateCollection(f.ctx, &types.MsgCreateCollection{
+		Creator:        artist,
+		Namespace:      "artworks",
+		CreationPolicy: types.CREATION_POLICY_OPEN,
+		Royalty:        &types.Royalty{Recipient: artist, BasisPoints: 1_000},
+	})
+	require.NoError(t, err)
+	inherited, err := srv.CreateItem(f.ctx, &types.MsgCreateItem{Creator: seller, Namespace: "artworks"})
+	require.NoError(t, err)
+	whole, err := srv.CreateItem(f.ctx, &types.MsgCreateItem{
+		Creator:   seller,
+		Namespace: "artworks",
+		Royalty:   &types.Royalty{Recipient: artist, BasisPoints: types.MaxRoyaltyBasisPoints},
+	})
+	require.NoError(t, err)
+
+	_, err = srv.CreateItem(f.ctx, &types.MsgCreateItem{
+		Creator:   seller,
+		Namespace: "artworks",
+		Royalty:   &types.Royalty{Recipient: artist, BasisPoints: types.MaxRoyaltyBasisPoints + 1},
+	})
+	require.ErrorIs(t, err, types.ErrInvalidRoyalty)
+	_, err = srv.CreateItem(f.ctx, &types.MsgCreateItem{
+		Creator:   seller,
+		Namespace: "artworks",
+		Royalty:   &types.Royalty{Recipient: "invalid", BasisPoints: 100},
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+
+	// Changing the collection royalty does not affect the existing items
+	_, err = srv.UpdateCollection(f.ctx, &types.MsgUpdateCollection{
+		Creator:        artist,
+		Namespace:      "artworks",
+		CreationPolicy: types.CREATION_POLICY_OPEN,
+	})
+	require.NoError(t, err)
+
+	price := sdk.NewInt64Coin("oms", 1_000)
+	info, err := qs.RoyaltyInfo(f.ctx, &types.QueryRoyaltyInfoRequest{Id: inherited.Id, SalePrice: price})
+	require.NoError(t, err)
+	require.Equal(t, artist, info.Receiver)
+	require.Equal(t, sdk.NewInt64Coin("oms", 100), info.RoyaltyAmount)
+
+	buyerAddr, err := f.addressCodec.StringToBytes(buyer)
+	require.NoError(t, err)
+	sellerAddr, err := f.addressCodec.StringToBytes(seller)
+	require.NoError(t, err)
+	artistAddr, err := f.addressCodec.StringToBytes(artist)
+	require.NoError(t, err)
+	balanceOf := func(addr []byte) math.Int {
+		return f.bankKeeper.balances[sdk.AccAddress(addr).String()].AmountOf("oms")
+	}
+	f.bankKeeper.balances[sdk.AccAddress(buyerAddr).String()] = sdk.NewCoins(price.Add(price))
+
+	_, err = srv.ListItem(f.ctx, &types.MsgListItem{Creator: seller, Id: inherited.Id, Price: price})
+	require.NoError(t, err)
+	_, err = srv.BuyItem(f.ctx, &types.MsgBuyItem{Creator: buyer, Id: inherited.Id, Price: price})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(100), balanceOf(artistAddr))
+	require.Equal(t, math.NewInt(850), balanceOf(sellerAddr))
+
+	// The royalty is capped at what is left after the marketplace fee
+	_, err = srv.ListItem(f.ctx, &types.MsgListItem{Creator: seller, Id: whole.Id, Price: price})
+	require.NoError(t, err)
+	_, err = srv.BuyItem(f.ctx, &types.MsgBuyItem{Creator: buyer, Id: whole.Id, Price: price})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(1_050), balanceOf(artistAddr))
+	require.Equal(t, math.NewInt(850), balanceOf(sellerAddr))
+	require.True(t, balanceOf(buyerAddr).IsZero())
+
+	// Items created after the update have no royalty
+	plain, err := srv.CreateItem(f.ctx, &types.MsgCreateItem{Creator: seller, Namespace: "artworks"})
+	require.NoError(t, err)
+	info, err = qs.RoyaltyInfo(f.ctx, &types.QueryRoyaltyInfoRequest{Id: plain.Id, SalePrice: price})
+	require.NoError(t, err)
+	require.Empty(t, info.Receiver)
+	require.True(t, info.RoyaltyAmount.IsZero())
+}
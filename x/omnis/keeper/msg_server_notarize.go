@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"omnis/x/omnis/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) Notarize(ctx context.Context, msg *types.MsgNotarize) (*types.MsgNotarizeResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	if err := msg.ContentHash.Validate(); err != nil {
+		return nil, err
+	}
+	if err := types.ValidateItemURI(msg.Uri); err != nil {
+		return nil, err
+	}
+
+	contentHash := msg.ContentHash
+	id, err := k.createItem(ctx, types.Item{
+		Name:        msg.Name,
+		Owner:       msg.Creator,
+		Creator:     msg.Creator,
+		Alias:       msg.Alias,
+		Namespace:   msg.Namespace,
+		ContentHash: &contentHash,
+		Uri:         msg.Uri,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the first registration proves existence, later ones are indexed
+	// but do not move its height and time.
+	key := contentHash.IndexKey()
+	found, err := k.Notarizations.Has(ctx, key)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get notarization")
+	}
+	if !found {
+		sdkCtx := sdk.UnwrapSDKContext(ctx)
+		if err := k.Notarizations.Set(ctx, key, types.Notarization{
+			ContentHash: contentHash,
+			ItemId:      id,
+			Notary:      msg.Creator,
+			Height:      sdkCtx.BlockHeight(),
+			Time:        sdkCtx.BlockTime(),
+		}); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set notarization")
+		}
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventItemNotarized{
+		Id:        id,
+		Algorithm: contentHash.Algorithm,
+		Hash:      contentHash.Hash,
+		First:     !found,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgNotarizeResponse{Id: id}, nil
+}
+
+// GetNotarization returns the first registration of a content hash, or
+// collections.ErrNotFound if it was never notarized.
+func (k Keeper) GetNotarization(ctx context.Context, contentHash types.ContentHash) (types.Notarization, error) {
+	return k.Notarizations.Get(ctx, contentHash.IndexKey())
+}
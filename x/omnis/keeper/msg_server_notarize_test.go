@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"omnis/x/omnis/keeper"
+	"omnis/x/omnis/types"
+)
+
+func TestNotarizeMsgServer(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	otherCreator, err := f.addressCodec.BytesToString([]byte("otherSignerAddr_____________"))
+	require.NoError(t, err)
+
+	namespace := createOpenCollection(t, f, creator)
+
+	digest := sha256.Sum256([]byte("contract"))
+	contentHash := types.ContentHash{Algorithm: types.HASH_ALGORITHM_SHA256, Hash: hex.EncodeToString(digest[:])}
+
+	tests := []struct {
+		desc    string
+		request *types.MsgNotarize
+		err     error
+	}{
+		{
+			desc:    "invalid address",
+			request: &types.MsgNotarize{Creator: "invalid", Namespace: namespace, ContentHash: contentHash},
+			err:     sdkerrors.ErrInvalidAddress,
+		},
+		{
+			desc:    "unspecified algorithm",
+			request: &types.MsgNotarize{Creator: creator, Namespace: namespace, ContentHash: types.ContentHash{Hash: contentHash.Hash}},
+			err:     types.ErrInvalidContentHash,
+		},
+		{
+			desc: "uppercase hash",
+			request: &types.MsgNotarize{Creator: creator, Namespace: namespace, ContentHash: types.ContentHash{
+				Algorithm: types.HASH_ALGORITHM_SHA256, Hash: strings.ToUpper(contentHash.Hash),
+			}},
+			err: types.ErrInvalidContentHash,
+		},
+		{
+			desc: "truncated hash",
+			request: &types.MsgNotarize{Creator: creator, Namespace: namespace, ContentHash: types.ContentHash{
+				Algorithm: types.HASH_ALGORITHM_BLAKE2B_256, Hash: contentHash.Hash[:32],
+			}},
+			err: types.ErrInvalidContentHash,
+		},
+		{
+			desc:    "invalid uri",
+			request: &types.MsgNotarize{Creator: creator, Namespace: namespace, ContentHash: contentHash, Uri: "not a uri"},
+			err:     types.ErrInvalidURI,
+		},
+		{
+			desc:    "unknown collection",
+			request: &types.MsgNotarize{Creator: creator, Namespace: "unknown", ContentHash: contentHash},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "completed",
+			request: &types.MsgNotarize{Creator: creator, Namespace: namespace, ContentHash: contentHash, Uri: "ipfs://bafybeigdyrzt"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.Notarize(f.ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	firstHeight := sdk.UnwrapSDKContext(f.ctx).BlockHeight()
+
+	// Registering the same hash again at a later height keeps the first registration
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(firstHeight + 10)
+	resp, err := srv.Notarize(ctx, &types.MsgNotarize{Creator: otherCreator, Namespace: namespace, ContentHash: contentHash})
+	require.NoError(t, err)
+
+	found, err := qs.ByContentHash(ctx, &types.QueryByContentHashRequest{Algorithm: contentHash.Algorithm, Hash: contentHash.Hash})
+	require.NoError(t, err)
+	require.Equal(t, creator, found.FirstRegistration.Notary)
+	require.Equal(t, firstHeight, found.FirstRegistration.Height)
+	require.Len(t, found.Items, 2)
+	require.Equal(t, "ipfs://bafybeigdyrzt", found.Items[0].Uri)
+
+	_, err = qs.ByContentHash(ctx, &types.QueryByContentHashRequest{Algorithm: types.HASH_ALGORITHM_BLAKE2B_256, Hash: contentHash.Hash})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	// The proof of existence outlives the items
+	_, err = srv.DeleteItem(ctx, &types.MsgDeleteItem{Creator: creator, Id: found.FirstRegistration.ItemId})
+	require.NoError(t, err)
+	_, err = srv.DeleteItem(ctx, &types.MsgDeleteItem{Creator: otherCreator, Id: resp.Id})
+	require.NoError(t, err)
+
+	found, err = qs.ByContentHash(ctx, &types.QueryByContentHashRequest{Algorithm: contentHash.Algorithm, Hash: contentHash.Hash})
+	require.NoError(t, err)
+	require.Equal(t, firstHeight, found.FirstRegistration.Height)
+	require.Empty(t, found.Items)
+}
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"omnis/x/omnis/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) SetItemUser(ctx context.Context, msg *types.MsgSetItemUser) (*types.MsgSetItemUserResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	item, err := k.getEditableItem(ctx, msg.Id, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if item.Expired {
+		return nil, errorsmod.Wrapf(types.ErrItemExpired, "item %d expired", item.Id)
+	}
+
+	prev := item
+	if msg.User == "" {
+		if item.User == "" {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "item %d has no user", item.Id)
+		}
+		item.User, item.UserExpiresAt = "", nil
+	} else {
+		if _, err := k.addressCodec.StringToBytes(msg.User); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid user address: %s", err))
+		}
+		if msg.User == item.Owner {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "the owner cannot be the user")
+		}
+		if msg.ExpiresAt == nil {
+			return nil, errorsmod.Wrap(types.ErrInvalidExpiry, "a rental must have an expiry time")
+		}
+		if err := validateExpiry(ctx, msg.ExpiresAt); err != nil {
+			return nil, err
+		}
+		item.User, item.UserExpiresAt = msg.User, msg.ExpiresAt
+	}
+
+	if err := k.recordItemRevision(ctx, prev, &item, msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to record item revision")
+	}
+	if err := k.SetItem(ctx, item); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update item")
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventItemUserSet{
+		Id:        item.Id,
+		Owner:     item.Owner,
+		User:      item.User,
+		ExpiresAt: item.UserExpiresAt,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetItemUserResponse{}, nil
+}
+
+// getUsableItem returns the item with the given id after checking that sender
+// may act on it: its active user, its owner or an approved operator. Only the
+// latter two may transfer it.
+func (k Keeper) getUsableItem(ctx context.Context, id uint64, sender string) (types.Item, error) {
+	item, err := k.getExistingItem(ctx, id)
+	if err != nil {
+		return types.Item{}, err
+	}
+	if item.ActiveUser(sdk.UnwrapSDKContext(ctx).BlockTime()) == sender {
+		return item, nil
+	}
+	if err := k.checkEditor(ctx, item, sender); err != nil {
+		return types.Item{}, err
+	}
+	return item, nil
+}
@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"omnis/x/omnis/keeper"
+	"omnis/x/omnis/types"
+)
+
+func TestItemRental(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	owner, err := f.addressCodec.BytesToString([]byte("ownerAddr___________________"))
+	require.NoError(t, err)
+	renter, err := f.addressCodec.BytesToString([]byte("renterAddr__________________"))
+	require.NoError(t, err)
+	buyer, err := f.addressCodec.BytesToString([]byte("buyerAddr___________________"))
+	require.NoError(t, err)
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+	namespace := createOpenCollection(t, f, owner)
+	resp, err := srv.CreateItem(ctx, &types.MsgCreateItem{Creator: owner, Namespace: namespace})
+	require.NoError(t, err)
+
+	past := now.Add(-time.Hour)
+	end := now.Add(time.Hour)
+	tests := []struct {
+		desc    string
+		request *types.MsgSetItemUser
+		err     error
+	}{
+		{
+			desc:    "not the owner",
+			request: &types.MsgSetItemUser{Creator: renter, Id: resp.Id, User: renter, ExpiresAt: &end},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "no expiry",
+			request: &types.MsgSetItemUser{Creator: owner, Id: resp.Id, User: renter},
+			err:     types.ErrInvalidExpiry,
+		},
+		{
+			desc:    "past expiry",
+			request: &types.MsgSetItemUser{Creator: owner, Id: resp.Id, User: renter, ExpiresAt: &past},
+			err:     types.ErrInvalidExpiry,
+		},
+		{
+			desc:    "owner as user",
+			request: &types.MsgSetItemUser{Creator: owner, Id: resp.Id, User: owner, ExpiresAt: &end},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "no user to clear",
+			request: &types.MsgSetItemUser{Creator: owner, Id: resp.Id},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "completed",
+			request: &types.MsgSetItemUser{Creator: owner, Id: resp.Id, User: renter, ExpiresAt: &end},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.SetItemUser(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	got, err := qs.GetItem(ctx, &types.QueryGetItemRequest{Id: resp.Id})
+	require.NoError(t, err)
+	require.Equal(t, renter, got.ActiveUser)
+
+	// The user acts on the item but cannot transfer it
+	_, err = srv.UpdateItem(ctx, &types.MsgUpdateItem{Creator: renter, Id: resp.Id, NewName: "rented"})
+	require.NoError(t, err)
+	_, err = srv.TransferItem(ctx, &types.MsgTransferItem{Creator: renter, Id: resp.Id, NewOwner: renter})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// Nothing is due before the end of the rental
+	require.NoError(t, f.keeper.ProcessExpiredUsers(ctx))
+	item, err := f.keeper.GetItem(ctx, resp.Id)
+	require.NoError(t, err)
+	require.Equal(t, renter, item.User)
+
+	ctx = ctx.WithBlockTime(end)
+	got, err = qs.GetItem(ctx, &types.QueryGetItemRequest{Id: resp.Id})
+	require.NoError(t, err)
+	require.Empty(t, got.ActiveUser)
+
+	require.NoError(t, f.keeper.ProcessExpiredUsers(ctx))
+	item, err = f.keeper.GetItem(ctx, resp.Id)
+	require.NoError(t, err)
+	require.Empty(t, item.User)
+	require.Nil(t, item.UserExpiresAt)
+
+	_, err = srv.UpdateItem(ctx, &types.MsgUpdateItem{Creator: renter, Id: resp.Id, NewName: "returned"})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// The rental ends when the item changes hands
+	later := end.Add(time.Hour)
+	_, err = srv.SetItemUser(ctx, &types.MsgSetItemUser{Creator: owner, Id: resp.Id, User: renter, ExpiresAt: &later})
+	require.NoError(t, err)
+	_, err = srv.TransferItem(ctx, &types.MsgTransferItem{Creator: owner, Id: resp.Id, NewOwner: buyer})
+	require.NoError(t, err)
+	item, err = f.keeper.GetItem(ctx, resp.Id)
+	require.NoError(t, err)
+	require.Empty(t, item.User)
+}
@@ -36,11 +36,20 @@ func TestMsgUpdateParams(t *testing.T) {
 			expErrMsg: "invalid authority",
 		},
 		{
-			name: "send enabled param",
+			name: "no item revisions",
 			input: &types.MsgUpdateParams{
 				Authority: authorityStr,
 				Params:    types.Params{},
 			},
+			expErr:    true,
+			expErrMsg: "max item revisions must be positive",
+		},
+		{
+			name: "lower item revisions",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    types.NewParams(1, types.DefaultMaxExpirationsPerBlock, types.DefaultMarketplaceFee, nil, types.DefaultMaxBatchSize),
+			},
 			expErr: false,
 		},
 		{
@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"omnis/x/omnis/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+)
+
+// saveCollectionClass creates or updates the NFT class mirroring a
+// collection.
+func (k Keeper) saveCollectionClass(ctx context.Context, collection types.ItemCollection) error {
+	if k.nftKeeper == nil {
+		return nil
+	}
+	class := collection.NFTClass()
+	if k.nftKeeper.HasClass(ctx, class.Id) {
+		return k.nftKeeper.UpdateClass(ctx, class)
+	}
+	return k.nftKeeper.SaveClass(ctx, class)
+}
+
+// mintItemNFT mints the NFT mirroring an item to its owner, unless it exists.
+func (k Keeper) mintItemNFT(ctx context.Context, item types.Item) error {
+	if k.nftKeeper == nil {
+		return nil
+	}
+	token := item.NFT()
+	if k.nftKeeper.HasNFT(ctx, token.ClassId, token.Id) {
+		return nil
+	}
+	owner, err := k.addressCodec.StringToBytes(item.Owner)
+	if err != nil {
+		return err
+	}
+	return k.nftKeeper.Mint(ctx, token, owner)
+}
+
+// burnItemNFT burns the NFT mirroring an item, if it exists.
+func (k Keeper) burnItemNFT(ctx context.Context, item types.Item) error {
+	if k.nftKeeper == nil {
+		return nil
+	}
+	token := item.NFT()
+	if !k.nftKeeper.HasNFT(ctx, token.ClassId, token.Id) {
+		return nil
+	}
+	return k.nftKeeper.Burn(ctx, token.ClassId, token.Id)
+}
+
+// transferItemNFT moves the NFT mirroring an item to the owner of the item.
+func (k Keeper) transferItemNFT(ctx context.Context, item types.Item) error {
+	if k.nftKeeper == nil {
+		return nil
+	}
+	token := item.NFT()
+	if !k.nftKeeper.HasNFT(ctx, token.ClassId, token.Id) {
+		return k.mintItemNFT(ctx, item)
+	}
+	owner, err := k.addressCodec.StringToBytes(item.Owner)
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(k.nftKeeper.GetOwner(ctx, token.ClassId, token.Id), owner) {
+		return nil
+	}
+	return k.nftKeeper.Transfer(ctx, token.ClassId, token.Id, owner)
+}
+
+// SyncNFTOwner hands an item over to the owner of the NFT mirroring it, after
+// the NFT was sent through the x/nft module. NFTs which do not mirror an item
+// are ignored. Expired items cannot change hands, so syncing them fails.
+func (k Keeper) SyncNFTOwner(ctx context.Context, classID, nftID string) error {
+	if k.nftKeeper == nil {
+		return nil
+	}
+	id, ok := types.ParseNFT(classID, nftID)
+	if !ok {
+		return nil
+	}
+	item, err := k.GetItem(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	if types.NFTClassID(item.Namespace) != classID {
+		return nil
+	}
+
+	owner, err := k.addressCodec.BytesToString(k.nftKeeper.GetOwner(ctx, classID, nftID))
+	if err != nil {
+		return err
+	}
+	if owner == item.Owner {
+		return nil
+	}
+	if item.Expired {
+		return errorsmod.Wrapf(types.ErrItemExpired, "item %d expired", item.Id)
+	}
+	return k.transferItem(ctx, item, owner, item.Owner, types.CUSTODY_REASON_NFT_TRANSFER, "")
+}
+
+// MirrorNFTs creates the NFT classes and NFTs mirroring the collections and
+// items that are not mirrored yet. It is used to backfill x/nft when the
+// bridge is enabled on existing state.
+func (k Keeper) MirrorNFTs(ctx context.Context) error {
+	if k.nftKeeper == nil {
+		return nil
+	}
+
+	err := k.ItemCollection.Walk(ctx, nil, func(_ string, collection types.ItemCollection) (bool, error) {
+		return false, k.saveCollectionClass(ctx, collection)
+	})
+	if err != nil {
+		return err
+	}
+
+	return k.IterateItems(ctx, func(_ uint64, item types.Item) (bool, error) {
+		return false, k.mintItemNFT(ctx, item)
+	})
+}
@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"omnis/x/omnis/keeper"
+	"omnis/x/omnis/types"
+)
+
+func TestNFTBridge(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	owner, err := f.addressCodec.BytesToString([]byte("ownerAddr___________________"))
+	require.NoError(t, err)
+	receiver, err := f.addressCodec.BytesToString([]byte("receiverAddr________________"))
+	require.NoError(t, err)
+	receiverAddr, err := f.addressCodec.StringToBytes(receiver)
+	require.NoError(t, err)
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+
+	// Collections are mirrored as classes
+	namespace := createOpenCollection(t, f, owner)
+	classID := types.NFTClassID(namespace)
+	require.True(t, f.nftKeeper.HasClass(ctx, classID))
+	_, err = srv.UpdateCollection(ctx, &types.MsgUpdateCollection{
+		Creator:        owner,
+		Namespace:      namespace,
+		Description:    "mirrored",
+		CreationPolicy: types.CREATION_POLICY_OPEN,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "mirrored", f.nftKeeper.classes[classID].Description)
+
+	// Items are minted to their owner
+	resp, err := srv.CreateItem(ctx, &types.MsgCreateItem{Creator: owner, Namespace: namespace})
+	require.NoError(t, err)
+	nftID := types.NFTID(resp.Id)
+	require.True(t, f.nftKeeper.HasNFT(ctx, classID, nftID))
+
+	// Transfers of the item move the NFT
+	_, err = srv.TransferItem(ctx, &types.MsgTransferItem{Creator: owner, Id: resp.Id, NewOwner: receiver})
+	require.NoError(t, err)
+	require.Equal(t, sdk.AccAddress(receiverAddr), f.nftKeeper.GetOwner(ctx, classID, nftID))
+
+	// Transfers of the NFT move the item
+	ownerAddr, err := f.addressCodec.StringToBytes(owner)
+	require.NoError(t, err)
+	require.NoError(t, f.nftKeeper.Transfer(ctx, classID, nftID, ownerAddr))
+	require.NoError(t, f.keeper.SyncNFTOwner(ctx, classID, nftID))
+	item, err := f.keeper.GetItem(ctx, resp.Id)
+	require.NoError(t, err)
+	require.Equal(t, owner, item.Owner)
+
+	// NFTs which do not mirror an item are ignored
+	require.NoError(t, f.keeper.SyncNFTOwner(ctx, "kitties", nftID))
+	require.NoError(t, f.keeper.SyncNFTOwner(ctx, classID, "100"))
+
+	// Expired items cannot change hands
+	expiresAt := now.Add(time.Hour)
+	expiring, err := srv.CreateItem(ctx, &types.MsgCreateItem{Creator: owner, Namespace: namespace, ExpiresAt: &expiresAt})
+	require.NoError(t, err)
+	ctx = ctx.WithBlockTime(expiresAt)
+	require.NoError(t, f.keeper.ProcessExpiredItems(ctx))
+	require.NoError(t, f.nftKeeper.Transfer(ctx, classID, types.NFTID(expiring.Id), receiverAddr))
+	require.ErrorIs(t, f.keeper.SyncNFTOwner(ctx, classID, types.NFTID(expiring.Id)), types.ErrItemExpired)
+
+	// Deleted items are burnt
+	_, err = srv.DeleteItem(ctx, &types.MsgDeleteItem{Creator: owner, Id: resp.Id})
+	require.NoError(t, err)
+	require.False(t, f.nftKeeper.HasNFT(ctx, classID, nftID))
+}
+
+func TestMirrorNFTs(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	owner, err := f.addressCodec.BytesToString([]byte("ownerAddr___________________"))
+	require.NoError(t, err)
+	namespace := createOpenCollection(t, f, owner)
+	resp, err := srv.CreateItem(f.ctx, &types.MsgCreateItem{Creator: owner, Namespace: namespace})
+	require.NoError(t, err)
+
+	// Forget what was mirrored, as if the bridge was enabled on existing state
+	*f.nftKeeper = *newMockNFTKeeper()
+
+	require.NoError(t, f.keeper.MirrorNFTs(f.ctx))
+	classID := types.NFTClassID(namespace)
+	require.True(t, f.nftKeeper.HasClass(f.ctx, classID))
+	require.True(t, f.nftKeeper.HasNFT(f.ctx, classID, types.NFTID(resp.Id)))
+
+	// Mirroring again is a no-op
+	require.NoError(t, f.keeper.MirrorNFTs(f.ctx))
+}
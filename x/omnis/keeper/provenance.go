@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"context"
+
+	"omnis/x/omnis/types"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// recordCustody appends a custody change of an item to its chain of custody.
+// Records are never updated nor deleted, not even with the item.
+func (k Keeper) recordCustody(ctx context.Context, id uint64, from, to string, reason types.CustodyReason, memo string) error {
+	sequence, err := k.nextCustodySequence(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return k.Provenance.Set(ctx, collections.Join(id, sequence), types.CustodyRecord{
+		ItemId:   id,
+		Sequence: sequence,
+		From:     from,
+		To:       to,
+		Height:   sdkCtx.BlockHeight(),
+		Time:     sdkCtx.BlockTime(),
+		Reason:   reason,
+		Memo:     memo,
+	})
+}
+
+// nextCustodySequence returns the sequence following the last custody record
+// of an item.
+func (k Keeper) nextCustodySequence(ctx context.Context, id uint64) (uint64, error) {
+	iter, err := k.Provenance.Iterate(ctx, collections.NewPrefixedPairRange[uint64, uint64](id).Descending())
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return 0, nil
+	}
+	key, err := iter.Key()
+	if err != nil {
+		return 0, err
+	}
+	return key.K2() + 1, nil
+}
+
+// BackfillProvenance starts the chain of custody of the items created before
+// custody changes were recorded with their current owner.
+func (k Keeper) BackfillProvenance(ctx context.Context) error {
+	// The items are collected first, the store is not written while iterated
+	var items []types.Item
+	err := k.IterateItems(ctx, func(_ uint64, item types.Item) (bool, error) {
+		has, err := k.Provenance.Has(ctx, collections.Join(item.Id, uint64(0)))
+		if err != nil {
+			return true, err
+		}
+		if !has {
+			items = append(items, item)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		if err := k.recordCustody(ctx, item.Id, "", item.Owner, types.CUSTODY_REASON_MIGRATED, ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
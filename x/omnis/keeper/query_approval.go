@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+
+	"omnis/x/omnis/types"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ItemApprovals returns the active per-item approvals of an item. Lapsed
+// approvals are skipped.
+func (q queryServer) ItemApprovals(ctx context.Context, req *types.QueryItemApprovalsRequest) (*types.QueryItemApprovalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	approvals, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		q.k.ItemApprovals,
+		req.Pagination,
+		func(_ collections.Pair[uint64, sdk.AccAddress], value types.ItemApproval) (bool, error) {
+			return value.IsActive(now), nil
+		},
+		func(_ collections.Pair[uint64, sdk.AccAddress], value types.ItemApproval) (types.ItemApproval, error) {
+			return value, nil
+		},
+		query.WithCollectionPaginationPairPrefix[uint64, sdk.AccAddress](req.Id),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryItemApprovalsResponse{Approvals: approvals, Pagination: pageRes}, nil
+}
+
+// OperatorApprovals returns the active approvals an owner gave for all their
+// items. Lapsed approvals are skipped.
+func (q queryServer) OperatorApprovals(ctx context.Context, req *types.QueryOperatorApprovalsRequest) (*types.QueryOperatorApprovalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	owner, err := q.k.addressCodec.StringToBytes(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid owner address")
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	approvals, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		q.k.OperatorApprovals,
+		req.Pagination,
+		func(_ collections.Pair[sdk.AccAddress, sdk.AccAddress], value types.OperatorApproval) (bool, error) {
+			return value.IsActive(now), nil
+		},
+		func(_ collections.Pair[sdk.AccAddress, sdk.AccAddress], value types.OperatorApproval) (types.OperatorApproval, error) {
+			return value, nil
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, sdk.AccAddress](owner),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryOperatorApprovalsResponse{Approvals: approvals, Pagination: pageRes}, nil
+}
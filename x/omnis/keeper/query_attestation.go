@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"context"
+
+	"omnis/x/omnis/types"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) AttestationsByItem(ctx context.Context, req *types.QueryAttestationsByItemRequest) (*types.QueryAttestationsByItemResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	attestations, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		q.k.Attestations,
+		req.Pagination,
+		func(_ collections.Triple[uint64, sdk.AccAddress, string], attestation types.Attestation) (bool, error) {
+			return !req.ActiveOnly || attestation.IsActive(now), nil
+		},
+		func(_ collections.Triple[uint64, sdk.AccAddress, string], attestation types.Attestation) (types.Attestation, error) {
+			return attestation, nil
+		},
+		func(o *query.CollectionsPaginateOptions[collections.Triple[uint64, sdk.AccAddress, string]]) {
+			prefix := collections.TriplePrefix[uint64, sdk.AccAddress, string](req.Id)
+			o.Prefix = &prefix
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAttestationsByItemResponse{Attestations: attestations, Pagination: pageRes}, nil
+}
+
+func (q queryServer) AttestationsByAttester(ctx context.Context, req *types.QueryAttestationsByAttesterRequest) (*types.QueryAttestationsByAttesterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	attester, err := q.k.addressCodec.StringToBytes(req.Attester)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid attester address")
+	}
+
+	now := sdk.UnwrapSDKContext(ctx).BlockTime()
+	attestations, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		q.k.attestationsByAttester,
+		req.Pagination,
+		func(key collections.Pair[sdk.AccAddress, collections.Triple[uint64, sdk.AccAddress, string]], _ collections.NoValue) (bool, error) {
+			if !req.ActiveOnly {
+				return true, nil
+			}
+			attestation, err := q.k.Attestations.Get(ctx, key.K2())
+			if err != nil {
+				return false, err
+			}
+			return attestation.IsActive(now), nil
+		},
+		func(key collections.Pair[sdk.AccAddress, collections.Triple[uint64, sdk.AccAddress, string]], _ collections.NoValue) (types.Attestation, error) {
+			return q.k.Attestations.Get(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, collections.Triple[uint64, sdk.AccAddress, string]](attester),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAttestationsByAttesterResponse{Attestations: attestations, Pagination: pageRes}, nil
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"omnis/x/omnis/types"
+
+	"cosmossdk.io/collections"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) GetAttributeSchema(ctx context.Context, req *types.QueryGetAttributeSchemaRequest) (*types.QueryGetAttributeSchemaResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	schema, err := q.k.AttributeSchema.Get(ctx, req.Namespace)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetAttributeSchemaResponse{Schema: schema}, nil
+}
+
+func (q queryServer) ItemsByAttribute(ctx context.Context, req *types.QueryItemsByAttributeRequest) (*types.QueryItemsByAttributeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	value := req.Value
+	schema, err := q.k.GetAttributeSchema(ctx, req.Namespace)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	if schema != nil {
+		if def, found := schema.Definition(req.Key); found {
+			if value, err = types.NormalizeAttributeValue(def.Type, req.Value); err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+		}
+	}
+
+	items, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.itemsByAttribute,
+		req.Pagination,
+		func(key collections.Pair[string, uint64], _ collections.NoValue) (types.Item, error) {
+			return q.k.GetItem(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](types.AttributeIndexKey(req.Namespace, req.Key, value)),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryItemsByAttributeResponse{Items: items, Pagination: pageRes}, nil
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"omnis/x/omnis/types"
+
+	"cosmossdk.io/collections"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ClassTrace(ctx context.Context, req *types.QueryClassTraceRequest) (*types.QueryClassTraceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	hash, err := types.ParseClassHash(req.Hash)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	trace, err := q.k.ClassTraces.Get(ctx, hash)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryClassTraceResponse{ClassTrace: trace}, nil
+}
+
+func (q queryServer) ClassTraces(ctx context.Context, req *types.QueryClassTracesRequest) (*types.QueryClassTracesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	traces, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.ClassTraces,
+		req.Pagination,
+		func(_ string, trace types.ClassTrace) (types.ClassTrace, error) {
+			return trace, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryClassTracesResponse{ClassTraces: traces, Pagination: pageRes}, nil
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"omnis/x/omnis/types"
+
+	"cosmossdk.io/collections"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) GetCollection(ctx context.Context, req *types.QueryGetCollectionRequest) (*types.QueryGetCollectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	collection, err := q.k.ItemCollection.Get(ctx, req.Namespace)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	count, err := q.k.CollectionItemCount.Get(ctx, req.Namespace)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetCollectionResponse{Collection: collection, ItemCount: count}, nil
+}
+
+func (q queryServer) AllCollections(ctx context.Context, req *types.QueryAllCollectionsRequest) (*types.QueryAllCollectionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	itemCollections, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.ItemCollection,
+		req.Pagination,
+		func(_ string, value types.ItemCollection) (types.ItemCollection, error) {
+			return value, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllCollectionsResponse{Collections: itemCollections, Pagination: pageRes}, nil
+}
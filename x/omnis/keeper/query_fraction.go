@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"omnis/x/omnis/types"
+
+	"cosmossdk.io/collections"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) GetFraction(ctx context.Context, req *types.QueryGetFractionRequest) (*types.QueryGetFractionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	fraction, err := q.k.Fractions.Get(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetFractionResponse{Fraction: fraction}, nil
+}
+
+func (q queryServer) AllFractions(ctx context.Context, req *types.QueryAllFractionsRequest) (*types.QueryAllFractionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	fractions, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.Fractions,
+		req.Pagination,
+		func(_ uint64, fraction types.Fraction) (types.Fraction, error) {
+			return fraction, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllFractionsResponse{Fractions: fractions, Pagination: pageRes}, nil
+}
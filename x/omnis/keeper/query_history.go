@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+
+	"omnis/x/omnis/types"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ItemHistory returns the retained revisions of an item. The history of a
+// deleted item remains queryable.
+func (q queryServer) ItemHistory(ctx context.Context, req *types.QueryItemHistoryRequest) (*types.QueryItemHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	revisions, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.ItemRevisions,
+		req.Pagination,
+		func(_ collections.Pair[uint64, uint64], value types.ItemRevision) (types.ItemRevision, error) {
+			return value, nil
+		},
+		query.WithCollectionPaginationPairPrefix[uint64, uint64](req.Id),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryItemHistoryResponse{Revisions: revisions, Pagination: pageRes}, nil
+}
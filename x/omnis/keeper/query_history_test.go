@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"omnis/x/omnis/keeper"
+	"omnis/x/omnis/types"
+)
+
+func TestItemHistoryQuery(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	newOwner, err := f.addressCodec.BytesToString([]byte("newOwnerAddr________________"))
+	require.NoError(t, err)
+
+	namespace := createOpenCollection(t, f, creator)
+
+	resp, err := srv.CreateItem(f.ctx, &types.MsgCreateItem{Creator: creator, Namespace: namespace, Name: "pallet"})
+	require.NoError(t, err)
+	_, err = srv.UpdateItem(f.ctx, &types.MsgUpdateItem{Creator: creator, Id: resp.Id, NewName: "crate"})
+	require.NoError(t, err)
+	_, err = srv.TransferItem(f.ctx, &types.MsgTransferItem{Creator: creator, Id: resp.Id, NewOwner: newOwner})
+	require.NoError(t, err)
+
+	history, err := qs.ItemHistory(f.ctx, &types.QueryItemHistoryRequest{Id: resp.Id})
+	require.NoError(t, err)
+	require.Len(t, history.Revisions, 3)
+	require.Equal(t, []string{types.ItemFieldName, types.ItemFieldOwner, types.ItemFieldCreator, types.ItemFieldNamespace}, history.Revisions[0].ChangedFields)
+	require.Equal(t, []string{types.ItemFieldName}, history.Revisions[1].ChangedFields)
+	require.Equal(t, "pallet", history.Revisions[0].Item.Name)
+	require.Equal(t, creator, history.Revisions[1].Editor)
+	require.Equal(t, uint64(3), history.Revisions[2].Version)
+	require.Equal(t, newOwner, history.Revisions[2].Item.Owner)
+
+	item, err := f.keeper.GetItem(f.ctx, resp.Id)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), item.Version)
+
+	history, err = qs.ItemHistory(f.ctx, &types.QueryItemHistoryRequest{Id: resp.Id, Pagination: &query.PageRequest{Limit: 1, Reverse: true}})
+	require.NoError(t, err)
+	require.Len(t, history.Revisions, 1)
+	require.Equal(t, uint64(3), history.Revisions[0].Version)
+
+	// Lowering the retention prunes the oldest revisions on the next change
+	params := types.DefaultParams()
+	params.MaxItemRevisions = 2
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	_, err = srv.UpdateItem(f.ctx, &types.MsgUpdateItem{Creator: newOwner, Id: resp.Id, NewName: "box"})
+	require.NoError(t, err)
+
+	history, err = qs.ItemHistory(f.ctx, &types.QueryItemHistoryRequest{Id: resp.Id})
+	require.NoError(t, err)
+	require.Len(t, history.Revisions, 2)
+	require.Equal(t, uint64(3), history.Revisions[0].Version)
+	require.Equal(t, uint64(4), history.Revisions[1].Version)
+
+	// The history outlives the item
+	_, err = srv.DeleteItem(f.ctx, &types.MsgDeleteItem{Creator: newOwner, Id: resp.Id})
+	require.NoError(t, err)
+	history, err = qs.ItemHistory(f.ctx, &types.QueryItemHistoryRequest{Id: resp.Id})
+	require.NoError(t, err)
+	require.Len(t, history.Revisions, 2)
+}
@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"omnis/x/omnis/types"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) GetItem(ctx context.Context, req *types.QueryGetItemRequest) (*types.QueryGetItemResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	item, err := q.k.GetItem(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetItemResponse{
+		Item:       item,
+		ActiveUser: item.ActiveUser(sdk.UnwrapSDKContext(ctx).BlockTime()),
+	}, nil
+}
+
+func (q queryServer) GetItemByAlias(ctx context.Context, req *types.QueryGetItemByAliasRequest) (*types.QueryGetItemByAliasResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	creator, err := q.k.addressCodec.StringToBytes(req.Creator)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid creator address")
+	}
+
+	id, err := q.k.ItemAlias.Get(ctx, collections.Join(sdk.AccAddress(creator), req.Alias))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	item, err := q.k.GetItem(ctx, id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetItemByAliasResponse{Item: item}, nil
+}
+
+func (q queryServer) AllItems(ctx context.Context, req *types.QueryAllItemsRequest) (*types.QueryAllItemsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	items, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.Items,
+		req.Pagination,
+		func(_ uint64, value types.Item) (types.Item, error) {
+			return value, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllItemsResponse{Items: items, Pagination: pageRes}, nil
+}
+
+func (q queryServer) ItemsByOwner(ctx context.Context, req *types.QueryItemsByOwnerRequest) (*types.QueryItemsByOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	owner, err := q.k.addressCodec.StringToBytes(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid owner address")
+	}
+
+	items, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.itemsByOwner,
+		req.Pagination,
+		func(key collections.Pair[sdk.AccAddress, uint64], _ collections.NoValue) (types.Item, error) {
+			return q.k.GetItem(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](owner),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryItemsByOwnerResponse{Items: items, Pagination: pageRes}, nil
+}
@@ -0,0 +1,178 @@
+package keeper_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"omnis/x/omnis/keeper"
+	"omnis/x/omnis/types"
+)
+
+// createNItem stores n items, alternating their owner between the given owners.
+func createNItem(keeper keeper.Keeper, ctx context.Context, n int, owners ...string) []types.Item {
+	items := make([]types.Item, n)
+	for i := range items {
+		iu := uint64(i)
+		items[i].Id = iu
+		items[i].Name = strconv.Itoa(i)
+		items[i].Owner = owners[i%len(owners)]
+		items[i].Creator = items[i].Owner
+		_ = keeper.SetItem(ctx, items[i])
+		_ = keeper.ItemSeq.Set(ctx, iu)
+	}
+	return items
+}
+
+func TestItemQuerySingle(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	owner, err := f.addressCodec.BytesToString([]byte("ownerAddr___________________"))
+	require.NoError(t, err)
+	msgs := createNItem(f.keeper, f.ctx, 2, owner)
+
+	tests := []struct {
+		desc     string
+		request  *types.QueryGetItemRequest
+		response *types.QueryGetItemResponse
+		err      error
+	}{
+		{
+			desc:     "First",
+			request:  &types.QueryGetItemRequest{Id: msgs[0].Id},
+			response: &types.QueryGetItemResponse{Item: msgs[0]},
+		},
+		{
+			desc:     "Second",
+			request:  &types.QueryGetItemRequest{Id: msgs[1].Id},
+			response: &types.QueryGetItemResponse{Item: msgs[1]},
+		},
+		{
+			desc:    "KeyNotFound",
+			request: &types.QueryGetItemRequest{Id: uint64(len(msgs))},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := qs.GetItem(f.ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.EqualExportedValues(t, tc.response, response)
+			}
+		})
+	}
+}
+
+func TestItemQueryPaginated(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	owner, err := f.addressCodec.BytesToString([]byte("ownerAddr___________________"))
+	require.NoError(t, err)
+	msgs := createNItem(f.keeper, f.ctx, 5, owner)
+
+	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllItemsRequest {
+		return &types.QueryAllItemsRequest{
+			Pagination: &query.PageRequest{
+				Key:        next,
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: total,
+			},
+		}
+	}
+	t.Run("ByOffset", func(t *testing.T) {
+		step := 2
+		for i := 0; i < len(msgs); i += step {
+			resp, err := qs.AllItems(f.ctx, request(nil, uint64(i), uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Items), step)
+			require.Subset(t, msgs, resp.Items)
+		}
+	})
+	t.Run("ByKey", func(t *testing.T) {
+		step := 2
+		var next []byte
+		for i := 0; i < len(msgs); i += step {
+			resp, err := qs.AllItems(f.ctx, request(next, 0, uint64(step), false))
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Items), step)
+			require.Subset(t, msgs, resp.Items)
+			next = resp.Pagination.NextKey
+		}
+	})
+	t.Run("Total", func(t *testing.T) {
+		resp, err := qs.AllItems(f.ctx, request(nil, 0, 0, true))
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.EqualExportedValues(t, msgs, resp.Items)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := qs.AllItems(f.ctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
+
+func TestItemQueryByOwner(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	alice, err := f.addressCodec.BytesToString([]byte("aliceAddr___________________"))
+	require.NoError(t, err)
+	bob, err := f.addressCodec.BytesToString([]byte("bobAddr_____________________"))
+	require.NoError(t, err)
+	createNItem(f.keeper, f.ctx, 5, alice, bob)
+	// Items stored directly have no x/nft mirror until it is backfilled
+	require.NoError(t, f.keeper.ItemCollection.Set(f.ctx, "", types.ItemCollection{Admin: alice}))
+	require.NoError(t, f.keeper.MirrorNFTs(f.ctx))
+
+	resp, err := qs.ItemsByOwner(f.ctx, &types.QueryItemsByOwnerRequest{Owner: alice, Pagination: &query.PageRequest{CountTotal: true}})
+	require.NoError(t, err)
+	require.Equal(t, 3, int(resp.Pagination.Total))
+	for _, item := range resp.Items {
+		require.Equal(t, alice, item.Owner)
+	}
+
+	// Paginate over the items of bob by key
+	var (
+		next []byte
+		ids  []uint64
+	)
+	for {
+		resp, err := qs.ItemsByOwner(f.ctx, &types.QueryItemsByOwnerRequest{Owner: bob, Pagination: &query.PageRequest{Key: next, Limit: 1}})
+		require.NoError(t, err)
+		for _, item := range resp.Items {
+			ids = append(ids, item.Id)
+		}
+		next = resp.Pagination.NextKey
+		if next == nil {
+			break
+		}
+	}
+	require.Equal(t, []uint64{1, 3}, ids)
+
+	// The owner index follows transfers
+	_, err = srv.TransferItem(f.ctx, &types.MsgTransferItem{Creator: alice, Id: 0, NewOwner: bob})
+	require.NoError(t, err)
+	resp, err = qs.ItemsByOwner(f.ctx, &types.QueryItemsByOwnerRequest{Owner: bob, Pagination: &query.PageRequest{CountTotal: true}})
+	require.NoError(t, err)
+	require.Equal(t, 3, int(resp.Pagination.Total))
+
+	_, err = qs.ItemsByOwner(f.ctx, &types.QueryItemsByOwnerRequest{Owner: "invalid"})
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid owner address"))
+}
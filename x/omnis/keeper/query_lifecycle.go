@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"omnis/x/omnis/types"
+
+	"cosmossdk.io/collections"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) GetItemLifecycle(ctx context.Context, req *types.QueryGetItemLifecycleRequest) (*types.QueryGetItemLifecycleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	lifecycle, err := q.k.Lifecycles.Get(ctx, req.Namespace)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetItemLifecycleResponse{Lifecycle: lifecycle}, nil
+}
+
+func (q queryServer) ItemsByState(ctx context.Context, req *types.QueryItemsByStateRequest) (*types.QueryItemsByStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	items, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.itemsByState,
+		req.Pagination,
+		func(key collections.Pair[string, uint64], _ collections.NoValue) (types.Item, error) {
+			return q.k.GetItem(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](types.StateIndexKey(req.Namespace, req.State)),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryItemsByStateResponse{Items: items, Pagination: pageRes}, nil
+}
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"omnis/x/omnis/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) GetListing(ctx context.Context, req *types.QueryGetListingRequest) (*types.QueryGetListingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	listing, err := q.k.Listings.Get(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetListingResponse{Listing: listing}, nil
+}
+
+func (q queryServer) ListingsByCollection(ctx context.Context, req *types.QueryListingsByCollectionRequest) (*types.QueryListingsByCollectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	listings, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.listingsByCollection,
+		req.Pagination,
+		func(key collections.Pair[string, uint64], _ collections.NoValue) (types.Listing, error) {
+			return q.k.Listings.Get(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](req.Namespace),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryListingsByCollectionResponse{Listings: listings, Pagination: pageRes}, nil
+}
+
+func (q queryServer) ListingsBySeller(ctx context.Context, req *types.QueryListingsBySellerRequest) (*types.QueryListingsBySellerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	seller, err := q.k.addressCodec.StringToBytes(req.Seller)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid seller address")
+	}
+
+	listings, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.listingsBySeller,
+		req.Pagination,
+		func(key collections.Pair[sdk.AccAddress, uint64], _ collections.NoValue) (types.Listing, error) {
+			return q.k.Listings.Get(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](seller),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryListingsBySellerResponse{Listings: listings, Pagination: pageRes}, nil
+}
+
+func (q queryServer) RoyaltyInfo(ctx context.Context, req *types.QueryRoyaltyInfoRequest) (*types.QueryRoyaltyInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := req.SalePrice.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	item, err := q.k.GetItem(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	if item.Royalty == nil {
+		return &types.QueryRoyaltyInfoResponse{RoyaltyAmount: sdk.NewCoin(req.SalePrice.Denom, math.ZeroInt())}, nil
+	}
+	return &types.QueryRoyaltyInfoResponse{
+		Receiver:      item.Royalty.Recipient,
+		RoyaltyAmount: item.Royalty.RoyaltyOf(req.SalePrice),
+	}, nil
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"omnis/x/omnis/types"
+
+	"cosmossdk.io/collections"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ByContentHash(ctx context.Context, req *types.QueryByContentHashRequest) (*types.QueryByContentHashResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	contentHash := types.ContentHash{Algorithm: req.Algorithm, Hash: req.Hash}
+	if err := contentHash.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	notarization, err := q.k.GetNotarization(ctx, contentHash)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	items, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.itemsByContentHash,
+		req.Pagination,
+		func(key collections.Pair[string, uint64], _ collections.NoValue) (types.Item, error) {
+			return q.k.GetItem(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](contentHash.IndexKey()),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryByContentHashResponse{FirstRegistration: notarization, Items: items, Pagination: pageRes}, nil
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+
+	"omnis/x/omnis/types"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ItemProvenance returns the chain of custody of an item, oldest first. The
+// chain of a deleted item remains queryable.
+func (q queryServer) ItemProvenance(ctx context.Context, req *types.QueryItemProvenanceRequest) (*types.QueryItemProvenanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	records, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.Provenance,
+		req.Pagination,
+		func(_ collections.Pair[uint64, uint64], value types.CustodyRecord) (types.CustodyRecord, error) {
+			return value, nil
+		},
+		query.WithCollectionPaginationPairPrefix[uint64, uint64](req.Id),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryItemProvenanceResponse{Records: records, Pagination: pageRes}, nil
+}
@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"omnis/x/omnis/keeper"
+	"omnis/x/omnis/types"
+)
+
+func TestItemProvenanceQuery(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	newOwner, err := f.addressCodec.BytesToString([]byte("newOwnerAddr________________"))
+	require.NoError(t, err)
+
+	namespace := createOpenCollection(t, f, creator)
+	resp, err := srv.CreateItem(ctx, &types.MsgCreateItem{Creator: creator, Namespace: namespace})
+	require.NoError(t, err)
+
+	_, err = srv.TransferItem(ctx, types.NewMsgTransferItem(creator, resp.Id, newOwner, strings.Repeat("a", types.MaxCustodyMemoLength+1)))
+	require.ErrorIs(t, err, sdkerrors.ErrMemoTooLarge)
+	_, err = srv.TransferItem(ctx.WithBlockHeight(11), types.NewMsgTransferItem(creator, resp.Id, newOwner, "invoice 42"))
+	require.NoError(t, err)
+	_, err = srv.TransferItem(ctx.WithBlockHeight(12), types.NewMsgTransferItem(newOwner, resp.Id, creator, ""))
+	require.NoError(t, err)
+	_, err = srv.DeleteItem(ctx.WithBlockHeight(13), &types.MsgDeleteItem{Creator: creator, Id: resp.Id})
+	require.NoError(t, err)
+
+	// The chain of custody of a deleted item remains queryable
+	provenance, err := qs.ItemProvenance(ctx, &types.QueryItemProvenanceRequest{Id: resp.Id})
+	require.NoError(t, err)
+	require.Equal(t, []types.CustodyRecord{
+		{ItemId: resp.Id, Sequence: 0, To: creator, Height: 10, Time: ctx.BlockTime(), Reason: types.CUSTODY_REASON_CREATED},
+		{ItemId: resp.Id, Sequence: 1, From: creator, To: newOwner, Height: 11, Time: ctx.BlockTime(), Reason: types.CUSTODY_REASON_TRANSFER, Memo: "invoice 42"},
+		{ItemId: resp.Id, Sequence: 2, From: newOwner, To: creator, Height: 12, Time: ctx.BlockTime(), Reason: types.CUSTODY_REASON_TRANSFER},
+		{ItemId: resp.Id, Sequence: 3, From: creator, Height: 13, Time: ctx.BlockTime(), Reason: types.CUSTODY_REASON_DELETED},
+	}, provenance.Records)
+}
+
+func TestBackfillProvenance(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	owner, err := f.addressCodec.BytesToString([]byte("ownerAddr___________________"))
+	require.NoError(t, err)
+
+	namespace := createOpenCollection(t, f, owner)
+	resp, err := srv.CreateItem(f.ctx, &types.MsgCreateItem{Creator: owner, Namespace: namespace})
+	require.NoError(t, err)
+	// An item created before custody changes were recorded
+	legacy := types.Item{Id: 1, Owner: owner, Creator: owner, Namespace: namespace}
+	require.NoError(t, f.keeper.SetItem(f.ctx, legacy))
+
+	require.NoError(t, f.keeper.BackfillProvenance(f.ctx))
+
+	for id, reason := range map[uint64]types.CustodyReason{
+		resp.Id:   types.CUSTODY_REASON_CREATED,
+		legacy.Id: types.CUSTODY_REASON_MIGRATED,
+	} {
+		provenance, err := keeper.NewQueryServerImpl(f.keeper).ItemProvenance(f.ctx, &types.QueryItemProvenanceRequest{Id: id})
+		require.NoError(t, err)
+		require.Len(t, provenance.Records, 1)
+		require.Equal(t, reason, provenance.Records[0].Reason)
+		require.Equal(t, owner, provenance.Records[0].To)
+	}
+}
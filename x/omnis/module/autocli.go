@@ -17,6 +17,154 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Shows the parameters of the module",
 				},
+				{
+					RpcMethod:      "GetItem",
+					Use:            "get-item [id]",
+					Short:          "Gets an item by id",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "GetItemByAlias",
+					Use:            "get-item-by-alias [creator] [alias]",
+					Short:          "Gets an item by the alias its creator gave it",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "creator"}, {ProtoField: "alias"}},
+				},
+				{
+					RpcMethod: "AllItems",
+					Use:       "list-items",
+					Short:     "List all items",
+				},
+				{
+					RpcMethod:      "ItemsByOwner",
+					Use:            "items-by-owner [owner]",
+					Short:          "List the items owned by an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "owner"}},
+				},
+				{
+					RpcMethod:      "ItemHistory",
+					Use:            "item-history [id]",
+					Short:          "List the retained revisions of an item, oldest first (use --reverse for the latest first)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "ItemProvenance",
+					Use:            "item-provenance [id]",
+					Short:          "List the chain of custody of an item from its creation, including deleted items",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "ByContentHash",
+					Use:            "by-content-hash [algorithm] [hash]",
+					Short:          "Show when a content hash was first notarized, and the items notarizing it",
+					Example:        "by-content-hash HASH_ALGORITHM_SHA256 9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "algorithm"}, {ProtoField: "hash"}},
+				},
+				{
+					RpcMethod:      "ItemApprovals",
+					Use:            "item-approvals [id]",
+					Short:          "List the operators currently approved for an item",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "OperatorApprovals",
+					Use:            "operator-approvals [owner]",
+					Short:          "List the operators currently approved for all the items of an owner",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "owner"}},
+				},
+				{
+					RpcMethod:      "GetListing",
+					Use:            "get-listing [id]",
+					Short:          "Gets the marketplace listing of an item",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "ListingsByCollection",
+					Use:            "listings-by-collection [namespace]",
+					Short:          "List the marketplace listings of a collection",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "namespace"}},
+				},
+				{
+					RpcMethod:      "ListingsBySeller",
+					Use:            "listings-by-seller [seller]",
+					Short:          "List the marketplace listings of a seller",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "seller"}},
+				},
+				{
+					RpcMethod:      "RoyaltyInfo",
+					Use:            "royalty-info [id] [sale-price]",
+					Short:          "Shows the royalty owed on a sale of an item at a given price",
+					Example:        "royalty-info 1 1000stake",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "sale_price"}},
+				},
+				{
+					RpcMethod:      "GetCollection",
+					Use:            "get-collection [namespace]",
+					Short:          "Gets an item collection",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "namespace"}},
+				},
+				{
+					RpcMethod: "AllCollections",
+					Use:       "list-collections",
+					Short:     "List all item collections",
+				},
+				{
+					RpcMethod:      "GetAttributeSchema",
+					Use:            "get-attribute-schema [namespace]",
+					Short:          "Gets the attribute schema of a namespace",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "namespace"}},
+				},
+				{
+					RpcMethod:      "ItemsByAttribute",
+					Use:            "items-by-attribute [key] [value]",
+					Short:          "List the items of the --namespace collection whose attribute has a value",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "key"}, {ProtoField: "value"}},
+				},
+				{
+					RpcMethod:      "GetItemLifecycle",
+					Use:            "get-item-lifecycle [namespace]",
+					Short:          "Gets the lifecycle of a collection",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "namespace"}},
+				},
+				{
+					RpcMethod:      "ItemsByState",
+					Use:            "items-by-state [namespace] [state]",
+					Short:          "List the items of a collection in a lifecycle state",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "namespace"}, {ProtoField: "state"}},
+				},
+				{
+					RpcMethod:      "ClassTrace",
+					Use:            "class-trace [hash]",
+					Short:          "Gets the trace of an ICS-721 class received, by hash or voucher collection namespace",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "hash"}},
+				},
+				{
+					RpcMethod: "ClassTraces",
+					Use:       "class-traces",
+					Short:     "List the traces of the ICS-721 classes received",
+				},
+				{
+					RpcMethod:      "GetFraction",
+					Use:            "get-fraction [id]",
+					Short:          "Gets the fraction token and buyout state of a fractionalized item",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod: "AllFractions",
+					Use:       "list-fractions",
+					Short:     "List the fractionalized items",
+				},
+				{
+					RpcMethod:      "AttestationsByItem",
+					Use:            "attestations-by-item [id]",
+					Short:          "List the attestations of an item, optionally only the --active-only ones",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "AttestationsByAttester",
+					Use:            "attestations-by-attester [attester]",
+					Short:          "List the attestations made by an attester, optionally only the --active-only ones",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "attester"}},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -28,6 +176,199 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "UpdateParams",
 					Skip:      true, // skipped because authority gated
 				},
+				{
+					RpcMethod: "Notarize",
+					Skip:      true, // implemented in client/cli to hash files locally
+				},
+				{
+					RpcMethod: "BatchCreateItems",
+					Skip:      true, // implemented in client/cli to read items from a file
+				},
+				{
+					RpcMethod: "BatchUpdateItems",
+					Skip:      true, // implemented in client/cli to read items from a file
+				},
+				{
+					RpcMethod: "Attest",
+					Skip:      true, // implemented in client/cli to hash statements locally
+				},
+				{
+					RpcMethod:      "RevokeAttestation",
+					Use:            "revoke-attestation [id] [claim-type]",
+					Short:          "Revoke an attestation of the sender, or of an --attester of a collection administered by the sender",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "claim_type"}},
+				},
+				{
+					RpcMethod:      "CreateItem",
+					Use:            "create-item [namespace] [name]",
+					Short:          "Create a new item in a collection, optionally with a human-readable --alias and a --royalty",
+					Example:        `create-item artworks "Sunset" --royalty '{"recipient":"omnis1...","basis_points":500}'`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "namespace"}, {ProtoField: "name"}},
+				},
+				{
+					RpcMethod:      "UpdateItem",
+					Use:            "update-item [id] [new-name]",
+					Short:          "Rename an item",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "new_name"}},
+				},
+				{
+					RpcMethod:      "DeleteItem",
+					Use:            "delete-item [id]",
+					Short:          "Delete an item",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "TransferItem",
+					Use:            "transfer-item [id] [new-owner]",
+					Short:          "Transfer an item to a new owner, with an optional --memo recorded in its chain of custody",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "new_owner"}},
+				},
+				{
+					RpcMethod:      "SetItemAttributes",
+					Use:            "set-item-attributes [id]",
+					Short:          "Set typed attributes of an item",
+					Example:        `set-item-attributes 1 --attributes '{"key":"weight","type":"ATTRIBUTE_TYPE_DECIMAL","value":"12.5"}'`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "RemoveItemAttributes",
+					Use:            "remove-item-attributes [id] [keys]...",
+					Short:          "Remove attributes of an item",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "keys", Varargs: true}},
+				},
+				{
+					RpcMethod:      "SetAttributeSchema",
+					Use:            "set-attribute-schema [namespace]",
+					Short:          "Create or replace the attribute schema of a collection",
+					Example:        `set-attribute-schema logistics --definitions '{"key":"weight","type":"ATTRIBUTE_TYPE_DECIMAL","required":true}'`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "namespace"}},
+				},
+				{
+					RpcMethod:      "SetItemLifecycle",
+					Use:            "set-item-lifecycle [namespace] [initial-state]",
+					Short:          "Create or replace the lifecycle of a collection",
+					Example:        `set-item-lifecycle logistics created --states created,shipped,received --transitions '{"from":"created","to":"shipped","roles":["TRANSITION_ROLE_OWNER"]}' --transitions '{"from":"shipped","to":"received","accounts":["omnis1..."]}'`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "namespace"}, {ProtoField: "initial_state"}},
+				},
+				{
+					RpcMethod:      "TransitionItem",
+					Use:            "transition-item [id] [to-state]",
+					Short:          "Move an item to a new state of the lifecycle of its collection",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "to_state"}},
+				},
+				{
+					RpcMethod:      "CreateCollection",
+					Use:            "create-collection [namespace] [creation-policy]",
+					Short:          "Create an item collection administered by the sender",
+					Example:        "create-collection logistics CREATION_POLICY_ALLOWLIST --allowlist omnis1...,omnis1... --max-items 10000 --attesters omnis1...",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "namespace"}, {ProtoField: "creation_policy"}},
+				},
+				{
+					RpcMethod:      "UpdateCollection",
+					Use:            "update-collection [namespace] [creation-policy]",
+					Short:          "Replace the settings of an item collection, optionally handing it to a --new-admin",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "namespace"}, {ProtoField: "creation_policy"}},
+				},
+				{
+					RpcMethod:      "SetItemExpiry",
+					Use:            "set-item-expiry [id]",
+					Short:          "Set the --expires-at time of an item, or clear it when omitted",
+					Example:        "set-item-expiry 1 --expires-at 2027-01-01T00:00:00Z",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "ApproveItem",
+					Use:            "approve-item [id] [operator]",
+					Short:          "Let an operator update, transfer and delete an item, optionally until --expires-at",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "operator"}},
+				},
+				{
+					RpcMethod:      "RevokeItem",
+					Use:            "revoke-item [id] [operator]",
+					Short:          "Revoke the approval of an operator for an item",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "operator"}},
+				},
+				{
+					RpcMethod:      "ApproveAll",
+					Use:            "approve-all [operator]",
+					Short:          "Let an operator update, transfer and delete all your items, optionally until --expires-at",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "operator"}},
+				},
+				{
+					RpcMethod:      "RevokeAll",
+					Use:            "revoke-all [operator]",
+					Short:          "Revoke the approval of an operator for all your items",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "operator"}},
+				},
+				{
+					RpcMethod:      "ListItem",
+					Use:            "list-item [id] [price]",
+					Short:          "List an item for sale on the marketplace",
+					Example:        "list-item 1 100stake",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "price"}},
+				},
+				{
+					RpcMethod:      "DelistItem",
+					Use:            "delist-item [id]",
+					Short:          "Remove an item from the marketplace",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "UpdateListingPrice",
+					Use:            "update-listing-price [id] [price]",
+					Short:          "Change the price of a listed item",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "price"}},
+				},
+				{
+					RpcMethod:      "BuyItem",
+					Use:            "buy-item [id] [price]",
+					Short:          "Buy a listed item, the price must match the listing",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "price"}},
+				},
+				{
+					RpcMethod:      "SetItemUser",
+					Use:            "set-item-user [id] [user]",
+					Short:          "Rent an item out to a user until --expires-at, or end the rental when the user is omitted",
+					Example:        "set-item-user 1 omnis1... --expires-at 2027-01-01T00:00:00Z",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "user", Optional: true}},
+				},
+				{
+					RpcMethod: "IBCTransferItems",
+					Use:       "ibc-transfer-items [source-port] [source-channel] [namespace] [receiver] [timeout-timestamp] [ids]...",
+					Short:     "Send items of a collection to another chain over ICS-721, the timeout is in nanoseconds since the unix epoch",
+					Example:   "ibc-transfer-items nft-transfer channel-0 default cosmos1... 1798761600000000000 1 2",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "source_port"}, {ProtoField: "source_channel"}, {ProtoField: "namespace"},
+						{ProtoField: "receiver"}, {ProtoField: "timeout_timestamp"}, {ProtoField: "ids", Varargs: true},
+					},
+				},
+				{
+					RpcMethod: "FractionalizeItem",
+					Use:       "fractionalize-item [id] [symbol] [supply] [reserve-price]",
+					Short:     "Lock an item in escrow against a fraction token issued to the sender, optionally with a token --name",
+					Example:   "fractionalize-item 1 sunset 1000000 5000stake --name \"Sunset fractions\"",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"}, {ProtoField: "symbol"}, {ProtoField: "supply"}, {ProtoField: "reserve_price"},
+					},
+				},
+				{
+					RpcMethod:      "RedeemFraction",
+					Use:            "redeem-fraction [id]",
+					Short:          "Burn the whole supply of the fraction token of an item to release the item",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "BuyoutItem",
+					Use:            "buyout-item [id] [price]",
+					Short:          "Buy a fractionalized item at its reserve price",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "price"}},
+				},
+				{
+					RpcMethod:      "ClaimBuyoutProceeds",
+					Use:            "claim-buyout-proceeds [id]",
+					Short:          "Burn the fraction tokens of a bought out item for a share of its price",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
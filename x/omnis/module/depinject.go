@@ -8,6 +8,7 @@ import (
 	"cosmossdk.io/depinject/appconfig"
 	"github.com/cosmos/cosmos-sdk/codec"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	ibckeeper "github.com/cosmos/ibc-go/v10/modules/core/keeper"
 
 	"omnis/x/omnis/keeper"
 	"omnis/x/omnis/types"
@@ -35,6 +36,15 @@ type ModuleInputs struct {
 
 	AuthKeeper types.AuthKeeper
 	BankKeeper types.BankKeeper
+	// NFTKeeper is optional, items are mirrored to x/nft when it is provided.
+	NFTKeeper types.NFTKeeper `optional:"true"`
+	// IBCKeeperFn is optional, items can be sent over ICS-721 when it is
+	// provided. The IBC keeper does not support dependency injection yet, so
+	// the app supplies a getter.
+	IBCKeeperFn func() *ibckeeper.Keeper `optional:"true"`
+	// TokenKeeper is optional, items can be fractionalized when it is
+	// provided.
+	TokenKeeper types.TokenKeeper `optional:"true"`
 }
 
 type ModuleOutputs struct {
@@ -50,11 +60,24 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	if in.Config.Authority != "" {
 		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
 	}
+	var ics4WrapperFn func() types.ICS4Wrapper
+	if in.IBCKeeperFn != nil {
+		ics4WrapperFn = func() types.ICS4Wrapper {
+			if ibcKeeper := in.IBCKeeperFn(); ibcKeeper != nil {
+				return ibcKeeper.ChannelKeeper
+			}
+			return nil
+		}
+	}
 	k := keeper.NewKeeper(
 		in.StoreService,
 		in.Cdc,
 		in.AddressCodec,
 		authority,
+		in.BankKeeper,
+		in.NFTKeeper,
+		ics4WrapperFn,
+		in.TokenKeeper,
 	)
 	m := NewAppModule(in.Cdc, k, in.AuthKeeper, in.BankKeeper)
 
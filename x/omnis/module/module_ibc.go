@@ -0,0 +1,194 @@
+package omnis
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	"omnis/x/omnis/keeper"
+	"omnis/x/omnis/types"
+)
+
+var _ porttypes.IBCModule = IBCModule{}
+
+// IBCModule implements the ICS-26 interface for the ICS-721 transfer of items.
+type IBCModule struct {
+	keeper keeper.Keeper
+}
+
+// NewIBCModule creates a new IBCModule given the keeper.
+func NewIBCModule(k keeper.Keeper) IBCModule {
+	return IBCModule{
+		keeper: k,
+	}
+}
+
+// validateChannelParams checks that a channel is an unordered channel of the
+// ICS-721 port. Only 2^32 channels are allowed, as escrow addresses are
+// derived from the channel ids.
+func validateChannelParams(order channeltypes.Order, portID, channelID string) error {
+	channelSequence, err := channeltypes.ParseChannelSequence(channelID)
+	if err != nil {
+		return err
+	}
+	if channelSequence > uint64(math.MaxUint32) {
+		return errorsmod.Wrapf(channeltypes.ErrInvalidChannelIdentifier, "channel sequence %d is greater than max allowed %d", channelSequence, uint64(math.MaxUint32))
+	}
+	if order != channeltypes.UNORDERED {
+		return errorsmod.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s", channeltypes.UNORDERED, order)
+	}
+	if portID != types.PortID {
+		return errorsmod.Wrapf(porttypes.ErrInvalidPort, "invalid port: %s, expected %s", portID, types.PortID)
+	}
+	return nil
+}
+
+// OnChanOpenInit implements the IBCModule interface.
+func (im IBCModule) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	if err := validateChannelParams(order, portID, channelID); err != nil {
+		return "", err
+	}
+
+	if strings.TrimSpace(version) == "" {
+		version = types.Version
+	}
+	if version != types.Version {
+		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "got %s, expected %s", version, types.Version)
+	}
+
+	return version, nil
+}
+
+// OnChanOpenTry implements the IBCModule interface.
+func (im IBCModule) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	if err := validateChannelParams(order, portID, channelID); err != nil {
+		return "", err
+	}
+
+	if counterpartyVersion != types.Version {
+		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: got %s, expected %s", counterpartyVersion, types.Version)
+	}
+
+	return types.Version, nil
+}
+
+// OnChanOpenAck implements the IBCModule interface.
+func (im IBCModule) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	if counterpartyVersion != types.Version {
+		return errorsmod.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: got %s, expected %s", counterpartyVersion, types.Version)
+	}
+	return nil
+}
+
+// OnChanOpenConfirm implements the IBCModule interface.
+func (im IBCModule) OnChanOpenConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return nil
+}
+
+// OnChanCloseInit implements the IBCModule interface.
+func (im IBCModule) OnChanCloseInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	// Closing a channel would lock the items escrowed by it
+	return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "user cannot close channel")
+}
+
+// OnChanCloseConfirm implements the IBCModule interface.
+func (im IBCModule) OnChanCloseConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	return nil
+}
+
+// OnRecvPacket implements the IBCModule interface. The state changes are
+// reverted by core IBC when an error acknowledgement is returned.
+func (im IBCModule) OnRecvPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	data, err := types.UnmarshalPacketData(packet.GetData())
+	if err == nil {
+		err = im.keeper.OnRecvPacket(ctx, packet, data)
+	}
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("%s sequence %d", err.Error(), packet.Sequence), "module", types.ModuleName)
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+func (im IBCModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrUnknownRequest, "cannot unmarshal ICS-721 packet acknowledgement: %v", err)
+	}
+
+	data, err := types.UnmarshalPacketData(packet.GetData())
+	if err != nil {
+		return err
+	}
+
+	return im.keeper.OnAcknowledgementPacket(ctx, packet, data, ack)
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+func (im IBCModule) OnTimeoutPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	data, err := types.UnmarshalPacketData(packet.GetData())
+	if err != nil {
+		return err
+	}
+
+	return im.keeper.OnTimeoutPacket(ctx, packet, data)
+}
@@ -14,7 +14,9 @@ func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
 		accs[i] = acc.Address.String()
 	}
 	omnisGenesis := types.GenesisState{
-		Params: types.DefaultParams(),
+		Params:         types.DefaultParams(),
+		ItemList:       []types.Item{},
+		CollectionList: []types.ItemCollection{},
 	}
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&omnisGenesis)
 }
@@ -0,0 +1,13 @@
+package types
+
+import "time"
+
+// IsActive returns whether the approval has not lapsed at the given time.
+func (a ItemApproval) IsActive(now time.Time) bool {
+	return a.ExpiresAt == nil || now.Before(*a.ExpiresAt)
+}
+
+// IsActive returns whether the approval has not lapsed at the given time.
+func (a OperatorApproval) IsActive(now time.Time) bool {
+	return a.ExpiresAt == nil || now.Before(*a.ExpiresAt)
+}
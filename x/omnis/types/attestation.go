@@ -0,0 +1,41 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxClaimTypeLength is the maximum length of an attestation claim type.
+const MaxClaimTypeLength = 64
+
+// ValidateClaimType checks that a claim type follows the format of attribute
+// keys.
+func ValidateClaimType(claimType string) error {
+	if len(claimType) > MaxClaimTypeLength {
+		return errorsmod.Wrapf(ErrInvalidAttestation, "claim type is longer than %d characters", MaxClaimTypeLength)
+	}
+	if !attributeKeyRegex.MatchString(claimType) {
+		return errorsmod.Wrapf(ErrInvalidAttestation, "claim type %q must start with a letter and contain only letters, digits, '.', '_' or '-'", claimType)
+	}
+	return nil
+}
+
+// Validate performs basic validation of the attestation.
+func (a Attestation) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(a.Attester); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid attester: %s", err)
+	}
+	if err := ValidateClaimType(a.ClaimType); err != nil {
+		return err
+	}
+	return a.PayloadHash.Validate()
+}
+
+// IsActive returns whether the attestation has yet to expire at the given
+// time.
+func (a Attestation) IsActive(now time.Time) bool {
+	return a.ExpiresAt == nil || now.Before(*a.ExpiresAt)
+}
@@ -0,0 +1,588 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: omnis/omnis/v1/attestation.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Attestation is a statement signed by a trusted attester about an item, such
+// as "authentic" or "passed QA". The statement itself stays off-chain, only
+// its hash is recorded.
+type Attestation struct {
+	ItemId   uint64 `protobuf:"varint,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Attester string `protobuf:"bytes,2,opt,name=attester,proto3" json:"attester,omitempty"`
+	// claim_type names what is attested. An attester holds at most one
+	// attestation per claim type and item.
+	ClaimType   string      `protobuf:"bytes,3,opt,name=claim_type,json=claimType,proto3" json:"claim_type,omitempty"`
+	PayloadHash ContentHash `protobuf:"bytes,4,opt,name=payload_hash,json=payloadHash,proto3" json:"payload_hash"`
+	// expires_at is the optional end of validity of the attestation.
+	ExpiresAt  *time.Time `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+	AttestedAt time.Time  `protobuf:"bytes,6,opt,name=attested_at,json=attestedAt,proto3,stdtime" json:"attested_at"`
+}
+
+func (m *Attestation) Reset()         { *m = Attestation{} }
+func (m *Attestation) String() string { return proto.CompactTextString(m) }
+func (*Attestation) ProtoMessage()    {}
+func (*Attestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1dd12bdbddf2329, []int{0}
+}
+func (m *Attestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Attestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Attestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Attestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Attestation.Merge(m, src)
+}
+func (m *Attestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Attestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Attestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Attestation proto.InternalMessageInfo
+
+func (m *Attestation) GetItemId() uint64 {
+	if m != nil {
+		return m.ItemId
+	}
+	return 0
+}
+
+func (m *Attestation) GetAttester() string {
+	if m != nil {
+		return m.Attester
+	}
+	return ""
+}
+
+func (m *Attestation) GetClaimType() string {
+	if m != nil {
+		return m.ClaimType
+	}
+	return ""
+}
+
+func (m *Attestation) GetPayloadHash() ContentHash {
+	if m != nil {
+		return m.PayloadHash
+	}
+	return ContentHash{}
+}
+
+func (m *Attestation) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *Attestation) GetAttestedAt() time.Time {
+	if m != nil {
+		return m.AttestedAt
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*Attestation)(nil), "omnis.omnis.v1.Attestation")
+}
+
+func init() { proto.RegisterFile("omnis/omnis/v1/attestation.proto", fileDescriptor_b1dd12bdbddf2329) }
+
+var fileDescriptor_b1dd12bdbddf2329 = []byte{
+	// 372 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x51, 0x3d, 0x4f, 0xe3, 0x40,
+	0x10, 0xf5, 0xe6, 0x7c, 0xb9, 0x64, 0x7d, 0xba, 0xc2, 0x17, 0xe9, 0x7c, 0x41, 0x38, 0x86, 0x2a,
+	0x4d, 0x6c, 0x05, 0xe8, 0x91, 0x0d, 0x48, 0xd0, 0x9a, 0x54, 0x34, 0xd6, 0x26, 0x5e, 0x9c, 0x95,
+	0x62, 0xaf, 0xe5, 0x1d, 0xa2, 0x84, 0x5f, 0x91, 0x1f, 0xc3, 0x8f, 0x08, 0x5d, 0x44, 0x45, 0x05,
+	0x28, 0xf9, 0x23, 0x68, 0xbd, 0x0e, 0x5f, 0x0d, 0xcd, 0xc8, 0xf3, 0x66, 0xde, 0xf3, 0xdb, 0x37,
+	0xd8, 0xe1, 0x69, 0xc6, 0x84, 0xa7, 0xea, 0xb4, 0xef, 0x11, 0x00, 0x2a, 0x80, 0x00, 0xe3, 0x99,
+	0x9b, 0x17, 0x1c, 0xb8, 0xf9, 0xa7, 0x9c, 0xb9, 0xaa, 0x4e, 0xfb, 0xed, 0xff, 0x23, 0x2e, 0x52,
+	0x2e, 0xa2, 0x72, 0xea, 0xa9, 0x46, 0xad, 0xb6, 0x5b, 0x09, 0x4f, 0xb8, 0xc2, 0xe5, 0x57, 0x85,
+	0x76, 0x12, 0xce, 0x93, 0x09, 0xf5, 0xca, 0x6e, 0x78, 0x73, 0xed, 0x01, 0x4b, 0xe5, 0x3f, 0xd2,
+	0xbc, 0x5a, 0xd8, 0xfb, 0xe2, 0x21, 0xe3, 0x40, 0x0a, 0x76, 0xfb, 0xc1, 0xc4, 0xfe, 0x7d, 0x0d,
+	0x1b, 0xfe, 0xbb, 0x35, 0xf3, 0x1f, 0xfe, 0xc5, 0x80, 0xa6, 0x11, 0x8b, 0x2d, 0xe4, 0xa0, 0xae,
+	0x1e, 0xd6, 0x65, 0x7b, 0x11, 0x9b, 0x47, 0xb8, 0xa1, 0x9e, 0x40, 0x0b, 0xab, 0xe6, 0xa0, 0x6e,
+	0x33, 0xb0, 0x1e, 0xee, 0x7a, 0xad, 0xca, 0xa6, 0x1f, 0xc7, 0x05, 0x15, 0xe2, 0x12, 0x0a, 0x96,
+	0x25, 0xe1, 0xdb, 0xa6, 0xb9, 0x8b, 0xf1, 0x68, 0x42, 0x58, 0x1a, 0xc1, 0x3c, 0xa7, 0xd6, 0x0f,
+	0xc9, 0x0b, 0x9b, 0x25, 0x32, 0x98, 0xe7, 0xd4, 0x3c, 0xc5, 0xbf, 0x73, 0x32, 0x9f, 0x70, 0x12,
+	0x47, 0x63, 0x22, 0xc6, 0x96, 0xee, 0xa0, 0xae, 0x71, 0xb0, 0xe3, 0x7e, 0x4e, 0xc6, 0x3d, 0xe1,
+	0x19, 0xd0, 0x0c, 0xce, 0x89, 0x18, 0x07, 0xfa, 0xf2, 0xa9, 0xa3, 0x85, 0x46, 0x45, 0x93, 0x90,
+	0x79, 0x8c, 0x31, 0x9d, 0xe5, 0xac, 0xa0, 0x22, 0x22, 0x60, 0xfd, 0x2c, 0x35, 0xda, 0xae, 0x0a,
+	0xc7, 0xdd, 0x86, 0xe3, 0x0e, 0xb6, 0xe1, 0x04, 0xfa, 0xe2, 0xb9, 0x83, 0xc2, 0x66, 0xc5, 0xf1,
+	0xc1, 0x3c, 0xc3, 0x46, 0xe5, 0x38, 0x96, 0x0a, 0xf5, 0x6f, 0x15, 0x1a, 0xd2, 0x44, 0xa9, 0x82,
+	0xb7, 0x44, 0x1f, 0x82, 0xde, 0x72, 0x6d, 0xa3, 0xd5, 0xda, 0x46, 0x2f, 0x6b, 0x1b, 0x2d, 0x36,
+	0xb6, 0xb6, 0xda, 0xd8, 0xda, 0xe3, 0xc6, 0xd6, 0xae, 0xfe, 0xaa, 0x13, 0xcc, 0xaa, 0x53, 0xc8,
+	0x30, 0xc4, 0xb0, 0x5e, 0x0a, 0x1f, 0xbe, 0x06, 0x00, 0x00, 0xff, 0xff, 0x1d, 0xe3, 0x45, 0x0a,
+	0x2a, 0x02, 0x00, 0x00,
+}
+
+func (m *Attestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Attestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Attestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.AttestedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AttestedAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintAttestation(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if m.ExpiresAt != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintAttestation(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.PayloadHash.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAttestation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ClaimType) > 0 {
+		i -= len(m.ClaimType)
+		copy(dAtA[i:], m.ClaimType)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.ClaimType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Attester) > 0 {
+		i -= len(m.Attester)
+		copy(dAtA[i:], m.Attester)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Attester)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ItemId != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.ItemId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAttestation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAttestation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Attestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ItemId != 0 {
+		n += 1 + sovAttestation(uint64(m.ItemId))
+	}
+	l = len(m.Attester)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.ClaimType)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = m.PayloadHash.Size()
+	n += 1 + l + sovAttestation(uint64(l))
+	if m.ExpiresAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AttestedAt)
+	n += 1 + l + sovAttestation(uint64(l))
+	return n
+}
+
+func sovAttestation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAttestation(x uint64) (n int) {
+	return sovAttestation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Attestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttestation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Attestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Attestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemId", wireType)
+			}
+			m.ItemId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ItemId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attester", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadHash", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PayloadHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.AttestedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttestation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAttestation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAttestation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAttestation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAttestation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAttestation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAttestation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAttestation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAttestation = fmt.Errorf("proto: unexpected end of group")
+)
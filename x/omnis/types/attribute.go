@@ -0,0 +1,237 @@
+package types
+
+import (
+	"encoding/hex"
+	"math/big"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// MaxItemAttributes is the maximum number of attributes of an item.
+	MaxItemAttributes = 64
+	// MaxAttributeKeyLength is the maximum length of an attribute key.
+	MaxAttributeKeyLength = 64
+	// MaxAttributeValueLength is the maximum length of a string attribute.
+	MaxAttributeValueLength = 256
+	// hashLength is the length in bytes of a hash attribute.
+	hashLength = 32
+)
+
+var attributeKeyRegex = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9._-]*$`)
+
+// ValidateAttributeKey checks that key starts with a letter and contains only
+// letters, digits, '.', '_' or '-'.
+func ValidateAttributeKey(key string) error {
+	if len(key) > MaxAttributeKeyLength {
+		return errorsmod.Wrapf(ErrInvalidAttribute, "key is longer than %d characters", MaxAttributeKeyLength)
+	}
+	if !attributeKeyRegex.MatchString(key) {
+		return errorsmod.Wrapf(ErrInvalidAttribute, "key %q must start with a letter and contain only letters, digits, '.', '_' or '-'", key)
+	}
+	return nil
+}
+
+// NormalizeAttributeValue parses value as typ and returns its canonical
+// string form, which is what is stored and indexed.
+func NormalizeAttributeValue(typ AttributeType, value string) (string, error) {
+	switch typ {
+	case ATTRIBUTE_TYPE_STRING:
+		if len(value) > MaxAttributeValueLength {
+			return "", errorsmod.Wrapf(ErrInvalidAttribute, "string value is longer than %d characters", MaxAttributeValueLength)
+		}
+		if strings.ContainsRune(value, 0) {
+			return "", errorsmod.Wrap(ErrInvalidAttribute, "string value cannot contain a null character")
+		}
+		return value, nil
+	case ATTRIBUTE_TYPE_INT:
+		// Parse in base 10 only: math.NewIntFromString would read "0042" as
+		// octal and accept hex values.
+		i, ok := new(big.Int).SetString(value, 10)
+		if !ok || i.BitLen() > math.MaxBitLen {
+			return "", errorsmod.Wrapf(ErrInvalidAttribute, "invalid int value: %s", value)
+		}
+		return i.String(), nil
+	case ATTRIBUTE_TYPE_DECIMAL:
+		d, err := math.LegacyNewDecFromStr(value)
+		if err != nil {
+			return "", errorsmod.Wrapf(ErrInvalidAttribute, "invalid decimal value %s: %s", value, err)
+		}
+		return d.String(), nil
+	case ATTRIBUTE_TYPE_BOOL:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return "", errorsmod.Wrapf(ErrInvalidAttribute, "invalid bool value: %s", value)
+		}
+		return strconv.FormatBool(b), nil
+	case ATTRIBUTE_TYPE_TIMESTAMP:
+		t, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return "", errorsmod.Wrapf(ErrInvalidAttribute, "invalid RFC 3339 timestamp %s: %s", value, err)
+		}
+		return t.UTC().Format(time.RFC3339Nano), nil
+	case ATTRIBUTE_TYPE_HASH:
+		bz, err := hex.DecodeString(value)
+		if err != nil || len(bz) != hashLength {
+			return "", errorsmod.Wrapf(ErrInvalidAttribute, "hash must be %d hex encoded bytes: %s", hashLength, value)
+		}
+		return hex.EncodeToString(bz), nil
+	default:
+		return "", errorsmod.Wrapf(ErrInvalidAttribute, "unknown attribute type %s", typ)
+	}
+}
+
+// Normalize validates the attribute and returns it with its value in
+// canonical form.
+func (a Attribute) Normalize() (Attribute, error) {
+	if err := ValidateAttributeKey(a.Key); err != nil {
+		return Attribute{}, err
+	}
+	value, err := NormalizeAttributeValue(a.Type, a.Value)
+	if err != nil {
+		return Attribute{}, errorsmod.Wrapf(err, "attribute %s", a.Key)
+	}
+	a.Value = value
+	return a, nil
+}
+
+// NormalizeAttributes normalizes attributes and sorts them by key. Keys must
+// be unique.
+func NormalizeAttributes(attrs []Attribute) ([]Attribute, error) {
+	normalized := make([]Attribute, 0, len(attrs))
+	seen := make(map[string]bool, len(attrs))
+	for _, attr := range attrs {
+		if seen[attr.Key] {
+			return nil, errorsmod.Wrapf(ErrInvalidAttribute, "duplicated attribute %s", attr.Key)
+		}
+		seen[attr.Key] = true
+
+		attr, err := attr.Normalize()
+		if err != nil {
+			return nil, err
+		}
+		normalized = append(normalized, attr)
+	}
+	sort.Slice(normalized, func(i, j int) bool { return normalized[i].Key < normalized[j].Key })
+	return normalized, nil
+}
+
+// MergeAttributes returns the attributes with updates added or overwriting
+// the existing values, sorted by key. Both slices must be normalized.
+func MergeAttributes(attrs, updates []Attribute) []Attribute {
+	byKey := make(map[string]Attribute, len(attrs)+len(updates))
+	for _, attr := range attrs {
+		byKey[attr.Key] = attr
+	}
+	for _, attr := range updates {
+		byKey[attr.Key] = attr
+	}
+
+	merged := make([]Attribute, 0, len(byKey))
+	for _, attr := range byKey {
+		merged = append(merged, attr)
+	}
+	sort.Slice(merged, func(i, j int) bool { return merged[i].Key < merged[j].Key })
+	return merged
+}
+
+// RemoveAttributes returns the attributes without the given keys. Every key
+// must be set.
+func RemoveAttributes(attrs []Attribute, keys []string) ([]Attribute, error) {
+	remove := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		remove[key] = true
+	}
+
+	remaining := make([]Attribute, 0, len(attrs))
+	for _, attr := range attrs {
+		if remove[attr.Key] {
+			delete(remove, attr.Key)
+			continue
+		}
+		remaining = append(remaining, attr)
+	}
+	for _, key := range keys {
+		if remove[key] {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrKeyNotFound, "attribute %s is not set", key)
+		}
+	}
+	return remaining, nil
+}
+
+// ValidateItemAttributes checks the attributes of an item against the schema
+// of its collection, which may be nil.
+func ValidateItemAttributes(attrs []Attribute, schema *AttributeSchema) error {
+	if len(attrs) > MaxItemAttributes {
+		return errorsmod.Wrapf(ErrInvalidAttribute, "an item cannot have more than %d attributes", MaxItemAttributes)
+	}
+	if schema == nil {
+		return nil
+	}
+
+	set := make(map[string]bool, len(attrs))
+	for _, attr := range attrs {
+		def, found := schema.Definition(attr.Key)
+		if !found {
+			return errorsmod.Wrapf(ErrSchemaViolation, "attribute %s is not defined in collection %s", attr.Key, schema.Namespace)
+		}
+		if def.Type != attr.Type {
+			return errorsmod.Wrapf(ErrSchemaViolation, "attribute %s must be of type %s", attr.Key, def.Type)
+		}
+		set[attr.Key] = true
+	}
+	for _, def := range schema.Definitions {
+		if def.Required && !set[def.Key] {
+			return errorsmod.Wrapf(ErrSchemaViolation, "attribute %s is required in collection %s", def.Key, schema.Namespace)
+		}
+	}
+	return nil
+}
+
+// AttributeIndexKey returns the key under which items of the collection
+// namespace whose attribute key has value are indexed.
+func AttributeIndexKey(namespace, key, value string) string {
+	return namespace + "/" + key + "=" + value
+}
+
+// Definition returns the definition of key.
+func (s AttributeSchema) Definition(key string) (AttributeDefinition, bool) {
+	for _, def := range s.Definitions {
+		if def.Key == key {
+			return def, true
+		}
+	}
+	return AttributeDefinition{}, false
+}
+
+// Validate performs basic validation of the schema.
+func (s AttributeSchema) Validate() error {
+	if err := ValidateNamespace(s.Namespace); err != nil {
+		return err
+	}
+	if len(s.Definitions) > MaxItemAttributes {
+		return errorsmod.Wrapf(ErrInvalidAttribute, "a schema cannot define more than %d attributes", MaxItemAttributes)
+	}
+
+	seen := make(map[string]bool, len(s.Definitions))
+	for _, def := range s.Definitions {
+		if err := ValidateAttributeKey(def.Key); err != nil {
+			return err
+		}
+		if _, ok := AttributeType_name[int32(def.Type)]; !ok || def.Type == ATTRIBUTE_TYPE_UNSPECIFIED {
+			return errorsmod.Wrapf(ErrInvalidAttribute, "invalid type for attribute %s", def.Key)
+		}
+		if seen[def.Key] {
+			return errorsmod.Wrapf(ErrInvalidAttribute, "duplicated attribute %s", def.Key)
+		}
+		seen[def.Key] = true
+	}
+	return nil
+}
@@ -0,0 +1,951 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: omnis/omnis/v1/attribute.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AttributeType is the type of an item attribute value.
+type AttributeType int32
+
+const (
+	ATTRIBUTE_TYPE_UNSPECIFIED AttributeType = 0
+	// ATTRIBUTE_TYPE_STRING is a free-form UTF-8 string.
+	ATTRIBUTE_TYPE_STRING AttributeType = 1
+	// ATTRIBUTE_TYPE_INT is an arbitrary precision integer.
+	ATTRIBUTE_TYPE_INT AttributeType = 2
+	// ATTRIBUTE_TYPE_DECIMAL is a decimal number with up to 18 decimal places.
+	ATTRIBUTE_TYPE_DECIMAL AttributeType = 3
+	// ATTRIBUTE_TYPE_BOOL is "true" or "false".
+	ATTRIBUTE_TYPE_BOOL AttributeType = 4
+	// ATTRIBUTE_TYPE_TIMESTAMP is an RFC 3339 timestamp.
+	ATTRIBUTE_TYPE_TIMESTAMP AttributeType = 5
+	// ATTRIBUTE_TYPE_HASH is a hex encoded 32 bytes hash (e.g. sha256).
+	ATTRIBUTE_TYPE_HASH AttributeType = 6
+)
+
+var AttributeType_name = map[int32]string{
+	0: "ATTRIBUTE_TYPE_UNSPECIFIED",
+	1: "ATTRIBUTE_TYPE_STRING",
+	2: "ATTRIBUTE_TYPE_INT",
+	3: "ATTRIBUTE_TYPE_DECIMAL",
+	4: "ATTRIBUTE_TYPE_BOOL",
+	5: "ATTRIBUTE_TYPE_TIMESTAMP",
+	6: "ATTRIBUTE_TYPE_HASH",
+}
+
+var AttributeType_value = map[string]int32{
+	"ATTRIBUTE_TYPE_UNSPECIFIED": 0,
+	"ATTRIBUTE_TYPE_STRING":      1,
+	"ATTRIBUTE_TYPE_INT":         2,
+	"ATTRIBUTE_TYPE_DECIMAL":     3,
+	"ATTRIBUTE_TYPE_BOOL":        4,
+	"ATTRIBUTE_TYPE_TIMESTAMP":   5,
+	"ATTRIBUTE_TYPE_HASH":        6,
+}
+
+func (x AttributeType) String() string {
+	return proto.EnumName(AttributeType_name, int32(x))
+}
+
+func (AttributeType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e3ff0904449a1a85, []int{0}
+}
+
+// Attribute is a typed key/value pair attached to an item. The value is stored
+// in its canonical string form.
+type Attribute struct {
+	Key   string        `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Type  AttributeType `protobuf:"varint,2,opt,name=type,proto3,enum=omnis.omnis.v1.AttributeType" json:"type,omitempty"`
+	Value string        `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *Attribute) Reset()         { *m = Attribute{} }
+func (m *Attribute) String() string { return proto.CompactTextString(m) }
+func (*Attribute) ProtoMessage()    {}
+func (*Attribute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3ff0904449a1a85, []int{0}
+}
+func (m *Attribute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Attribute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Attribute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Attribute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Attribute.Merge(m, src)
+}
+func (m *Attribute) XXX_Size() int {
+	return m.Size()
+}
+func (m *Attribute) XXX_DiscardUnknown() {
+	xxx_messageInfo_Attribute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Attribute proto.InternalMessageInfo
+
+func (m *Attribute) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *Attribute) GetType() AttributeType {
+	if m != nil {
+		return m.Type
+	}
+	return ATTRIBUTE_TYPE_UNSPECIFIED
+}
+
+func (m *Attribute) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+// AttributeDefinition describes an attribute allowed by a schema.
+type AttributeDefinition struct {
+	Key  string        `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Type AttributeType `protobuf:"varint,2,opt,name=type,proto3,enum=omnis.omnis.v1.AttributeType" json:"type,omitempty"`
+	// required attributes must be set on every item of the collection.
+	Required bool `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
+}
+
+func (m *AttributeDefinition) Reset()         { *m = AttributeDefinition{} }
+func (m *AttributeDefinition) String() string { return proto.CompactTextString(m) }
+func (*AttributeDefinition) ProtoMessage()    {}
+func (*AttributeDefinition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3ff0904449a1a85, []int{1}
+}
+func (m *AttributeDefinition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttributeDefinition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttributeDefinition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttributeDefinition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttributeDefinition.Merge(m, src)
+}
+func (m *AttributeDefinition) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttributeDefinition) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttributeDefinition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttributeDefinition proto.InternalMessageInfo
+
+func (m *AttributeDefinition) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *AttributeDefinition) GetType() AttributeType {
+	if m != nil {
+		return m.Type
+	}
+	return ATTRIBUTE_TYPE_UNSPECIFIED
+}
+
+func (m *AttributeDefinition) GetRequired() bool {
+	if m != nil {
+		return m.Required
+	}
+	return false
+}
+
+// AttributeSchema constrains the attributes of the items of a collection.
+// When a collection has a schema, its items may only carry the attributes it
+// defines. The schema is managed by the collection admin.
+type AttributeSchema struct {
+	// namespace is the namespace of the collection the schema belongs to.
+	Namespace   string                `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Definitions []AttributeDefinition `protobuf:"bytes,3,rep,name=definitions,proto3" json:"definitions"`
+}
+
+func (m *AttributeSchema) Reset()         { *m = AttributeSchema{} }
+func (m *AttributeSchema) String() string { return proto.CompactTextString(m) }
+func (*AttributeSchema) ProtoMessage()    {}
+func (*AttributeSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3ff0904449a1a85, []int{2}
+}
+func (m *AttributeSchema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttributeSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttributeSchema.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttributeSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttributeSchema.Merge(m, src)
+}
+func (m *AttributeSchema) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttributeSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttributeSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttributeSchema proto.InternalMessageInfo
+
+func (m *AttributeSchema) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *AttributeSchema) GetDefinitions() []AttributeDefinition {
+	if m != nil {
+		return m.Definitions
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("omnis.omnis.v1.AttributeType", AttributeType_name, AttributeType_value)
+	proto.RegisterType((*Attribute)(nil), "omnis.omnis.v1.Attribute")
+	proto.RegisterType((*AttributeDefinition)(nil), "omnis.omnis.v1.AttributeDefinition")
+	proto.RegisterType((*AttributeSchema)(nil), "omnis.omnis.v1.AttributeSchema")
+}
+
+func init() { proto.RegisterFile("omnis/omnis/v1/attribute.proto", fileDescriptor_e3ff0904449a1a85) }
+
+var fileDescriptor_e3ff0904449a1a85 = []byte{
+	// 416 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcb, 0xcf, 0xcd, 0xcb,
+	0x2c, 0xd6, 0x87, 0x90, 0x65, 0x86, 0xfa, 0x89, 0x25, 0x25, 0x45, 0x99, 0x49, 0xa5, 0x25, 0xa9,
+	0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0x7c, 0x60, 0x19, 0x3d, 0x08, 0x59, 0x66, 0x28, 0x25,
+	0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x96, 0xd2, 0x07, 0xb1, 0x20, 0xaa, 0x94, 0xd2, 0xb8, 0x38, 0x1d,
+	0x61, 0x1a, 0x85, 0x04, 0xb8, 0x98, 0xb3, 0x53, 0x2b, 0x25, 0x18, 0x15, 0x18, 0x35, 0x38, 0x83,
+	0x40, 0x4c, 0x21, 0x43, 0x2e, 0x96, 0x92, 0xca, 0x82, 0x54, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x3e,
+	0x23, 0x59, 0x3d, 0x54, 0x33, 0xf5, 0xe0, 0x5a, 0x43, 0x2a, 0x0b, 0x52, 0x83, 0xc0, 0x4a, 0x85,
+	0x44, 0xb8, 0x58, 0xcb, 0x12, 0x73, 0x4a, 0x53, 0x25, 0x98, 0xc1, 0xc6, 0x40, 0x38, 0x4a, 0x65,
+	0x5c, 0xc2, 0x70, 0xc5, 0x2e, 0xa9, 0x69, 0x99, 0x79, 0x99, 0x25, 0x99, 0xf9, 0x79, 0xd4, 0xb1,
+	0x51, 0x8a, 0x8b, 0xa3, 0x28, 0xb5, 0xb0, 0x34, 0xb3, 0x28, 0x35, 0x05, 0x6c, 0x29, 0x47, 0x10,
+	0x9c, 0xaf, 0xd4, 0xc9, 0xc8, 0xc5, 0x0f, 0xd7, 0x13, 0x9c, 0x9c, 0x91, 0x9a, 0x9b, 0x28, 0x24,
+	0xc3, 0xc5, 0x99, 0x97, 0x98, 0x9b, 0x5a, 0x5c, 0x90, 0x98, 0x9c, 0x0a, 0xb5, 0x1a, 0x21, 0x20,
+	0xe4, 0xcd, 0xc5, 0x9d, 0x02, 0x77, 0x60, 0xb1, 0x04, 0xb3, 0x02, 0xb3, 0x06, 0xb7, 0x91, 0x32,
+	0x4e, 0x77, 0x20, 0x3c, 0xe3, 0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x10, 0xb2, 0x6e, 0x2f, 0x16,
+	0x0e, 0x26, 0x01, 0xe6, 0x20, 0xd6, 0xc4, 0x94, 0xdc, 0xcc, 0x3c, 0xad, 0x2b, 0x8c, 0x5c, 0xbc,
+	0x28, 0xee, 0x17, 0x92, 0xe3, 0x92, 0x72, 0x0c, 0x09, 0x09, 0xf2, 0x74, 0x0a, 0x0d, 0x71, 0x8d,
+	0x0f, 0x89, 0x0c, 0x70, 0x8d, 0x0f, 0xf5, 0x0b, 0x0e, 0x70, 0x75, 0xf6, 0x74, 0xf3, 0x74, 0x75,
+	0x11, 0x60, 0x10, 0x92, 0xe4, 0x12, 0x45, 0x93, 0x0f, 0x0e, 0x09, 0xf2, 0xf4, 0x73, 0x17, 0x60,
+	0x14, 0x12, 0xe3, 0x12, 0x42, 0x93, 0xf2, 0xf4, 0x0b, 0x11, 0x60, 0x12, 0x92, 0xe2, 0x12, 0x43,
+	0x13, 0x77, 0x71, 0x75, 0xf6, 0xf4, 0x75, 0xf4, 0x11, 0x60, 0x16, 0x12, 0xe7, 0x12, 0x46, 0x93,
+	0x73, 0xf2, 0xf7, 0xf7, 0x11, 0x60, 0x11, 0x92, 0xe1, 0x92, 0x40, 0x93, 0x08, 0xf1, 0xf4, 0x75,
+	0x0d, 0x0e, 0x71, 0xf4, 0x0d, 0x10, 0x60, 0xc5, 0xa2, 0xcd, 0xc3, 0x31, 0xd8, 0x43, 0x80, 0x4d,
+	0x8a, 0xa5, 0x63, 0xb1, 0x1c, 0x83, 0x93, 0xee, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31,
+	0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb,
+	0x31, 0x44, 0x09, 0x43, 0x12, 0x67, 0x05, 0x34, 0x91, 0x82, 0x22, 0xab, 0x38, 0x89, 0x0d, 0x9c,
+	0xf0, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x21, 0x18, 0x6f, 0x8d, 0xc0, 0x02, 0x00, 0x00,
+}
+
+func (m *Attribute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Attribute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Attribute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Type != 0 {
+		i = encodeVarintAttribute(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttributeDefinition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttributeDefinition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttributeDefinition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Required {
+		i--
+		if m.Required {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Type != 0 {
+		i = encodeVarintAttribute(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttributeSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttributeSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttributeSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Definitions) > 0 {
+		for iNdEx := len(m.Definitions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Definitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAttribute(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAttribute(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAttribute(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Attribute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovAttribute(uint64(m.Type))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
+}
+
+func (m *AttributeDefinition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovAttribute(uint64(m.Type))
+	}
+	if m.Required {
+		n += 2
+	}
+	return n
+}
+
+func (m *AttributeSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	if len(m.Definitions) > 0 {
+		for _, e := range m.Definitions {
+			l = e.Size()
+			n += 1 + l + sovAttribute(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAttribute(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAttribute(x uint64) (n int) {
+	return sovAttribute(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Attribute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Attribute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Attribute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= AttributeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttributeDefinition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttributeDefinition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttributeDefinition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= AttributeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Required", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Required = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttributeSchema) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttributeSchema: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttributeSchema: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Definitions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Definitions = append(m.Definitions, AttributeDefinition{})
+			if err := m.Definitions[len(m.Definitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAttribute(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAttribute
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAttribute
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAttribute
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAttribute        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAttribute          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAttribute = fmt.Errorf("proto: unexpected end of group")
+)
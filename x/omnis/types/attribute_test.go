@@ -0,0 +1,70 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"omnis/x/omnis/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeAttributeValue(t *testing.T) {
+	tests := []struct {
+		desc  string
+		typ   types.AttributeType
+		value string
+		exp   string
+		err   error
+	}{
+		{desc: "string", typ: types.ATTRIBUTE_TYPE_STRING, value: "Hamburg", exp: "Hamburg"},
+		{desc: "string too long", typ: types.ATTRIBUTE_TYPE_STRING, value: strings.Repeat("a", types.MaxAttributeValueLength+1), err: types.ErrInvalidAttribute},
+		{desc: "int", typ: types.ATTRIBUTE_TYPE_INT, value: "0042", exp: "42"},
+		{desc: "invalid int", typ: types.ATTRIBUTE_TYPE_INT, value: "4.2", err: types.ErrInvalidAttribute},
+		{desc: "decimal", typ: types.ATTRIBUTE_TYPE_DECIMAL, value: "12.5", exp: "12.500000000000000000"},
+		{desc: "bool", typ: types.ATTRIBUTE_TYPE_BOOL, value: "TRUE", exp: "true"},
+		{desc: "invalid bool", typ: types.ATTRIBUTE_TYPE_BOOL, value: "yes", err: types.ErrInvalidAttribute},
+		{desc: "timestamp", typ: types.ATTRIBUTE_TYPE_TIMESTAMP, value: "2024-01-02T03:04:05+02:00", exp: "2024-01-02T01:04:05Z"},
+		{desc: "invalid timestamp", typ: types.ATTRIBUTE_TYPE_TIMESTAMP, value: "2024-01-02", err: types.ErrInvalidAttribute},
+		{desc: "hash", typ: types.ATTRIBUTE_TYPE_HASH, value: strings.Repeat("AB", 32), exp: strings.Repeat("ab", 32)},
+		{desc: "short hash", typ: types.ATTRIBUTE_TYPE_HASH, value: "abcd", err: types.ErrInvalidAttribute},
+		{desc: "unspecified", typ: types.ATTRIBUTE_TYPE_UNSPECIFIED, value: "x", err: types.ErrInvalidAttribute},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, err := types.NormalizeAttributeValue(tc.typ, tc.value)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.exp, got)
+			}
+		})
+	}
+}
+
+func TestValidateItemAttributes(t *testing.T) {
+	schema := &types.AttributeSchema{
+		Namespace: "logistics",
+		Definitions: []types.AttributeDefinition{
+			{Key: "weight", Type: types.ATTRIBUTE_TYPE_DECIMAL, Required: true},
+			{Key: "fragile", Type: types.ATTRIBUTE_TYPE_BOOL},
+		},
+	}
+	require.NoError(t, schema.Validate())
+
+	weight := types.Attribute{Key: "weight", Type: types.ATTRIBUTE_TYPE_DECIMAL, Value: "1.000000000000000000"}
+	fragile := types.Attribute{Key: "fragile", Type: types.ATTRIBUTE_TYPE_BOOL, Value: "true"}
+
+	require.NoError(t, types.ValidateItemAttributes([]types.Attribute{fragile, weight}, schema))
+	require.NoError(t, types.ValidateItemAttributes([]types.Attribute{{Key: "color", Type: types.ATTRIBUTE_TYPE_STRING}}, nil))
+
+	err := types.ValidateItemAttributes([]types.Attribute{fragile}, schema)
+	require.ErrorIs(t, err, types.ErrSchemaViolation)
+
+	err = types.ValidateItemAttributes([]types.Attribute{weight, {Key: "color", Type: types.ATTRIBUTE_TYPE_STRING}}, schema)
+	require.ErrorIs(t, err, types.ErrSchemaViolation)
+
+	err = types.ValidateItemAttributes([]types.Attribute{{Key: "weight", Type: types.ATTRIBUTE_TYPE_INT, Value: "1"}}, schema)
+	require.ErrorIs(t, err, types.ErrSchemaViolation)
+}
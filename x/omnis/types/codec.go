@@ -7,6 +7,40 @@ import (
 )
 
 func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateItem{},
+		&MsgUpdateItem{},
+		&MsgDeleteItem{},
+		&MsgTransferItem{},
+		&MsgSetItemAttributes{},
+		&MsgRemoveItemAttributes{},
+		&MsgSetAttributeSchema{},
+		&MsgCreateCollection{},
+		&MsgUpdateCollection{},
+		&MsgNotarize{},
+		&MsgSetItemExpiry{},
+		&MsgApproveItem{},
+		&MsgRevokeItem{},
+		&MsgApproveAll{},
+		&MsgRevokeAll{},
+		&MsgListItem{},
+		&MsgDelistItem{},
+		&MsgUpdateListingPrice{},
+		&MsgBuyItem{},
+		&MsgSetItemUser{},
+		&MsgIBCTransferItems{},
+		&MsgFractionalizeItem{},
+		&MsgRedeemFraction{},
+		&MsgBuyoutItem{},
+		&MsgClaimBuyoutProceeds{},
+		&MsgBatchCreateItems{},
+		&MsgBatchUpdateItems{},
+		&MsgAttest{},
+		&MsgRevokeAttestation{},
+		&MsgSetItemLifecycle{},
+		&MsgTransitionItem{},
+	)
+
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
 	)
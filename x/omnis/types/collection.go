@@ -0,0 +1,93 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxCollectionDescriptionLength is the maximum length of a collection
+// description.
+const MaxCollectionDescriptionLength = 512
+
+// Validate performs basic validation of the collection.
+func (c ItemCollection) Validate() error {
+	if err := ValidateNamespace(c.Namespace); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(c.Admin); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid collection admin: %s", err)
+	}
+	if len(c.Description) > MaxCollectionDescriptionLength {
+		return errorsmod.Wrapf(ErrInvalidCollection, "description is longer than %d characters", MaxCollectionDescriptionLength)
+	}
+	if _, ok := CreationPolicy_name[int32(c.CreationPolicy)]; !ok || c.CreationPolicy == CREATION_POLICY_UNSPECIFIED {
+		return errorsmod.Wrapf(ErrInvalidCollection, "invalid creation policy %s", c.CreationPolicy)
+	}
+	if _, ok := ExpiryAction_name[int32(c.ExpiryAction)]; !ok {
+		return errorsmod.Wrapf(ErrInvalidCollection, "invalid expiry action %s", c.ExpiryAction)
+	}
+	if c.CreationPolicy != CREATION_POLICY_ALLOWLIST && len(c.Allowlist) > 0 {
+		return errorsmod.Wrap(ErrInvalidCollection, "an allowlist requires the allowlist creation policy")
+	}
+
+	if c.Royalty != nil {
+		if err := c.Royalty.Validate(); err != nil {
+			return err
+		}
+	}
+
+	seen := make(map[string]bool, len(c.Allowlist))
+	for _, addr := range c.Allowlist {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid allowlisted address %s: %s", addr, err)
+		}
+		if seen[addr] {
+			return errorsmod.Wrapf(ErrInvalidCollection, "duplicated allowlisted address %s", addr)
+		}
+		seen[addr] = true
+	}
+
+	seen = make(map[string]bool, len(c.Attesters))
+	for _, addr := range c.Attesters {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid attester address %s: %s", addr, err)
+		}
+		if seen[addr] {
+			return errorsmod.Wrapf(ErrInvalidCollection, "duplicated attester address %s", addr)
+		}
+		seen[addr] = true
+	}
+	return nil
+}
+
+// CanCreateItems returns whether addr may create items in the collection.
+func (c ItemCollection) CanCreateItems(addr string) bool {
+	switch c.CreationPolicy {
+	case CREATION_POLICY_OPEN:
+		return true
+	case CREATION_POLICY_ADMIN_ONLY:
+		return addr == c.Admin
+	case CREATION_POLICY_ALLOWLIST:
+		if addr == c.Admin {
+			return true
+		}
+		for _, allowed := range c.Allowlist {
+			if allowed == addr {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// IsAttester returns whether addr is trusted to attest the items of the
+// collection.
+func (c ItemCollection) IsAttester(addr string) bool {
+	for _, attester := range c.Attesters {
+		if attester == addr {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,832 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: omnis/omnis/v1/collection.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CreationPolicy defines who may create items in a collection.
+type CreationPolicy int32
+
+const (
+	CREATION_POLICY_UNSPECIFIED CreationPolicy = 0
+	// CREATION_POLICY_OPEN lets any account create items.
+	CREATION_POLICY_OPEN CreationPolicy = 1
+	// CREATION_POLICY_ADMIN_ONLY lets only the collection admin create items.
+	CREATION_POLICY_ADMIN_ONLY CreationPolicy = 2
+	// CREATION_POLICY_ALLOWLIST lets the admin and the allowlisted accounts
+	// create items.
+	CREATION_POLICY_ALLOWLIST CreationPolicy = 3
+)
+
+var CreationPolicy_name = map[int32]string{
+	0: "CREATION_POLICY_UNSPECIFIED",
+	1: "CREATION_POLICY_OPEN",
+	2: "CREATION_POLICY_ADMIN_ONLY",
+	3: "CREATION_POLICY_ALLOWLIST",
+}
+
+var CreationPolicy_value = map[string]int32{
+	"CREATION_POLICY_UNSPECIFIED": 0,
+	"CREATION_POLICY_OPEN":        1,
+	"CREATION_POLICY_ADMIN_ONLY":  2,
+	"CREATION_POLICY_ALLOWLIST":   3,
+}
+
+func (x CreationPolicy) String() string {
+	return proto.EnumName(CreationPolicy_name, int32(x))
+}
+
+func (CreationPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4451f5e2fb180c55, []int{0}
+}
+
+// ExpiryAction defines what happens to the items of a collection when they
+// expire.
+type ExpiryAction int32
+
+const (
+	// EXPIRY_ACTION_MARK_EXPIRED keeps expired items, flagged as expired.
+	EXPIRY_ACTION_MARK_EXPIRED ExpiryAction = 0
+	// EXPIRY_ACTION_DELETE deletes expired items.
+	EXPIRY_ACTION_DELETE ExpiryAction = 1
+)
+
+var ExpiryAction_name = map[int32]string{
+	0: "EXPIRY_ACTION_MARK_EXPIRED",
+	1: "EXPIRY_ACTION_DELETE",
+}
+
+var ExpiryAction_value = map[string]int32{
+	"EXPIRY_ACTION_MARK_EXPIRED": 0,
+	"EXPIRY_ACTION_DELETE":       1,
+}
+
+func (x ExpiryAction) String() string {
+	return proto.EnumName(ExpiryAction_name, int32(x))
+}
+
+func (ExpiryAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4451f5e2fb180c55, []int{1}
+}
+
+// ItemCollection is a namespace of items with its own admin and policies.
+// Every item belongs to exactly one collection.
+type ItemCollection struct {
+	// namespace is the unique identifier of the collection.
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// admin may update the collection and its attribute schema.
+	Admin          string         `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
+	Description    string         `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	CreationPolicy CreationPolicy `protobuf:"varint,4,opt,name=creation_policy,json=creationPolicy,proto3,enum=omnis.omnis.v1.CreationPolicy" json:"creation_policy,omitempty"`
+	// allowlist holds the accounts allowed to create items under
+	// CREATION_POLICY_ALLOWLIST.
+	Allowlist []string `protobuf:"bytes,5,rep,name=allowlist,proto3" json:"allowlist,omitempty"`
+	// max_items caps the number of items in the collection, 0 means no limit.
+	MaxItems uint64 `protobuf:"varint,6,opt,name=max_items,json=maxItems,proto3" json:"max_items,omitempty"`
+	// max_items_per_creator caps the number of items an account may have
+	// created in the collection, 0 means no limit.
+	MaxItemsPerCreator uint64       `protobuf:"varint,7,opt,name=max_items_per_creator,json=maxItemsPerCreator,proto3" json:"max_items_per_creator,omitempty"`
+	ExpiryAction       ExpiryAction `protobuf:"varint,8,opt,name=expiry_action,json=expiryAction,proto3,enum=omnis.omnis.v1.ExpiryAction" json:"expiry_action,omitempty"`
+	// royalty is the optional default royalty of the items created in the
+	// collection. Changing it does not affect the existing items.
+	Royalty *Royalty `protobuf:"bytes,9,opt,name=royalty,proto3" json:"royalty,omitempty"`
+	// attesters holds the accounts trusted to attest the items of the
+	// collection.
+	Attesters []string `protobuf:"bytes,10,rep,name=attesters,proto3" json:"attesters,omitempty"`
+}
+
+func (m *ItemCollection) Reset()         { *m = ItemCollection{} }
+func (m *ItemCollection) String() string { return proto.CompactTextString(m) }
+func (*ItemCollection) ProtoMessage()    {}
+func (*ItemCollection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4451f5e2fb180c55, []int{0}
+}
+func (m *ItemCollection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ItemCollection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ItemCollection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ItemCollection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ItemCollection.Merge(m, src)
+}
+func (m *ItemCollection) XXX_Size() int {
+	return m.Size()
+}
+func (m *ItemCollection) XXX_DiscardUnknown() {
+	xxx_messageInfo_ItemCollection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ItemCollection proto.InternalMessageInfo
+
+func (m *ItemCollection) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ItemCollection) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *ItemCollection) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *ItemCollection) GetCreationPolicy() CreationPolicy {
+	if m != nil {
+		return m.CreationPolicy
+	}
+	return CREATION_POLICY_UNSPECIFIED
+}
+
+func (m *ItemCollection) GetAllowlist() []string {
+	if m != nil {
+		return m.Allowlist
+	}
+	return nil
+}
+
+func (m *ItemCollection) GetMaxItems() uint64 {
+	if m != nil {
+		return m.MaxItems
+	}
+	return 0
+}
+
+func (m *ItemCollection) GetMaxItemsPerCreator() uint64 {
+	if m != nil {
+		return m.MaxItemsPerCreator
+	}
+	return 0
+}
+
+func (m *ItemCollection) GetExpiryAction() ExpiryAction {
+	if m != nil {
+		return m.ExpiryAction
+	}
+	return EXPIRY_ACTION_MARK_EXPIRED
+}
+
+func (m *ItemCollection) GetRoyalty() *Royalty {
+	if m != nil {
+		return m.Royalty
+	}
+	return nil
+}
+
+func (m *ItemCollection) GetAttesters() []string {
+	if m != nil {
+		return m.Attesters
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("omnis.omnis.v1.CreationPolicy", CreationPolicy_name, CreationPolicy_value)
+	proto.RegisterEnum("omnis.omnis.v1.ExpiryAction", ExpiryAction_name, ExpiryAction_value)
+	proto.RegisterType((*ItemCollection)(nil), "omnis.omnis.v1.ItemCollection")
+}
+
+func init() { proto.RegisterFile("omnis/omnis/v1/collection.proto", fileDescriptor_4451f5e2fb180c55) }
+
+var fileDescriptor_4451f5e2fb180c55 = []byte{
+	// 531 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xdd, 0x6e, 0xd3, 0x30,
+	0x14, 0xc7, 0xeb, 0x75, 0x5f, 0xf5, 0x46, 0xa9, 0xcc, 0x10, 0x5e, 0x37, 0xb2, 0x88, 0xab, 0x6a,
+	0xd2, 0x5a, 0x75, 0x48, 0xdc, 0x67, 0x69, 0x40, 0x11, 0x59, 0x1a, 0xa5, 0x45, 0x50, 0x6e, 0xac,
+	0x90, 0x5a, 0x55, 0xa4, 0xa4, 0x8e, 0x6c, 0x6b, 0xb4, 0x6f, 0xc0, 0xe5, 0xde, 0x81, 0x57, 0xe0,
+	0x21, 0xb8, 0x9c, 0xb8, 0xe2, 0x12, 0xb5, 0x0f, 0x02, 0x8a, 0xdd, 0x6e, 0x6d, 0x91, 0xe0, 0xc6,
+	0xf2, 0x39, 0xff, 0x9f, 0xcf, 0x97, 0x75, 0xe0, 0x19, 0xcb, 0xc6, 0x89, 0x68, 0xe9, 0xf3, 0xa6,
+	0xdd, 0x8a, 0x59, 0x9a, 0xd2, 0x58, 0x26, 0x6c, 0xdc, 0xcc, 0x39, 0x93, 0x0c, 0x55, 0x95, 0xd4,
+	0xd4, 0xe7, 0x4d, 0xbb, 0x7e, 0x1c, 0x33, 0x91, 0x31, 0x41, 0x94, 0xda, 0xd2, 0x86, 0x46, 0xeb,
+	0x47, 0x23, 0x36, 0x62, 0xda, 0x5f, 0xdc, 0x16, 0xde, 0xd3, 0x8d, 0x0c, 0x9c, 0x4d, 0xa3, 0x54,
+	0x4e, 0xb5, 0xfa, 0xe2, 0x77, 0x19, 0x56, 0x5d, 0x49, 0x33, 0xfb, 0x3e, 0x2f, 0x3a, 0x85, 0x95,
+	0x71, 0x94, 0x51, 0x91, 0x47, 0x31, 0xc5, 0xc0, 0x04, 0x8d, 0x4a, 0xf8, 0xe0, 0x40, 0x4d, 0xb8,
+	0x13, 0x0d, 0xb3, 0x64, 0x8c, 0xb7, 0x0a, 0xe5, 0x0a, 0xff, 0xf8, 0x76, 0x71, 0xb4, 0xa8, 0xc2,
+	0x1a, 0x0e, 0x39, 0x15, 0xa2, 0x27, 0x79, 0x32, 0x1e, 0x85, 0x1a, 0x43, 0x26, 0x3c, 0x18, 0x52,
+	0x11, 0xf3, 0x24, 0x2f, 0x82, 0xe3, 0xb2, 0x8a, 0xb7, 0xea, 0x42, 0x6f, 0xe0, 0xe3, 0x98, 0xd3,
+	0xa8, 0xb8, 0x93, 0x9c, 0xa5, 0x49, 0x3c, 0xc5, 0xdb, 0x26, 0x68, 0x54, 0x2f, 0x8d, 0xe6, 0x7a,
+	0xef, 0x4d, 0x7b, 0x81, 0x05, 0x8a, 0x0a, 0xab, 0xf1, 0x9a, 0x8d, 0x5e, 0xc1, 0x4a, 0x94, 0xa6,
+	0xec, 0x73, 0x9a, 0x08, 0x89, 0x77, 0xcc, 0xf2, 0x3f, 0xcb, 0x7b, 0x40, 0xd1, 0x09, 0xac, 0x64,
+	0xd1, 0x84, 0x24, 0x92, 0x66, 0x02, 0xef, 0x9a, 0xa0, 0xb1, 0x1d, 0xee, 0x67, 0xd1, 0xa4, 0x18,
+	0x8b, 0x40, 0x6d, 0xf8, 0xf4, 0x5e, 0x24, 0x39, 0xe5, 0x44, 0x25, 0x65, 0x1c, 0xef, 0x29, 0x10,
+	0x2d, 0xc1, 0x80, 0x72, 0x5b, 0x2b, 0xc8, 0x82, 0x8f, 0xe8, 0x24, 0x4f, 0xf8, 0x94, 0x44, 0x6a,
+	0xa2, 0x78, 0x5f, 0xb5, 0x73, 0xba, 0xd9, 0x8e, 0xa3, 0x20, 0x4b, 0x31, 0xe1, 0x21, 0x5d, 0xb1,
+	0x50, 0x1b, 0xee, 0x2d, 0xfe, 0x09, 0x57, 0x4c, 0xd0, 0x38, 0xb8, 0x7c, 0xb6, 0xf9, 0x38, 0xd4,
+	0x72, 0xb8, 0xe4, 0x54, 0xf7, 0x52, 0x52, 0x21, 0x29, 0x17, 0x18, 0xfe, 0xb7, 0xfb, 0x25, 0x7a,
+	0x7e, 0x0b, 0x60, 0x75, 0x7d, 0xb0, 0xe8, 0x0c, 0x9e, 0xd8, 0xa1, 0x63, 0xf5, 0xdd, 0xae, 0x4f,
+	0x82, 0xae, 0xe7, 0xda, 0x03, 0xf2, 0xce, 0xef, 0x05, 0x8e, 0xed, 0xbe, 0x76, 0x9d, 0x4e, 0xad,
+	0x84, 0x30, 0x3c, 0xda, 0x04, 0xba, 0x81, 0xe3, 0xd7, 0x00, 0x32, 0x60, 0x7d, 0x53, 0xb1, 0x3a,
+	0xd7, 0xae, 0x4f, 0xba, 0xbe, 0x37, 0xa8, 0x6d, 0xa1, 0xe7, 0xf0, 0xf8, 0x2f, 0xdd, 0xf3, 0xba,
+	0xef, 0x3d, 0xb7, 0xd7, 0xaf, 0x95, 0xeb, 0xdb, 0x5f, 0xbe, 0x1a, 0xa5, 0x73, 0x1f, 0x1e, 0xae,
+	0xce, 0xa6, 0x08, 0xea, 0x7c, 0x08, 0xdc, 0x70, 0x40, 0x2c, 0x5b, 0xbd, 0xbc, 0xb6, 0xc2, 0xb7,
+	0x44, 0xb9, 0x96, 0xe5, 0xac, 0xeb, 0x1d, 0xc7, 0x73, 0xfa, 0x4e, 0x0d, 0xe8, 0x78, 0x57, 0x17,
+	0xdf, 0x67, 0x06, 0xb8, 0x9b, 0x19, 0xe0, 0xd7, 0xcc, 0x00, 0xb7, 0x73, 0xa3, 0x74, 0x37, 0x37,
+	0x4a, 0x3f, 0xe7, 0x46, 0xe9, 0xe3, 0x13, 0xbd, 0x16, 0x93, 0xc5, 0x7a, 0xc8, 0x69, 0x4e, 0xc5,
+	0xa7, 0x5d, 0xb5, 0x1a, 0x2f, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xac, 0x9d, 0x5b, 0x02, 0x9c,
+	0x03, 0x00, 0x00,
+}
+
+func (m *ItemCollection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ItemCollection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ItemCollection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attesters) > 0 {
+		for iNdEx := len(m.Attesters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Attesters[iNdEx])
+			copy(dAtA[i:], m.Attesters[iNdEx])
+			i = encodeVarintCollection(dAtA, i, uint64(len(m.Attesters[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.Royalty != nil {
+		{
+			size, err := m.Royalty.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCollection(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.ExpiryAction != 0 {
+		i = encodeVarintCollection(dAtA, i, uint64(m.ExpiryAction))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxItemsPerCreator != 0 {
+		i = encodeVarintCollection(dAtA, i, uint64(m.MaxItemsPerCreator))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxItems != 0 {
+		i = encodeVarintCollection(dAtA, i, uint64(m.MaxItems))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allowlist[iNdEx])
+			copy(dAtA[i:], m.Allowlist[iNdEx])
+			i = encodeVarintCollection(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.CreationPolicy != 0 {
+		i = encodeVarintCollection(dAtA, i, uint64(m.CreationPolicy))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintCollection(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintCollection(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintCollection(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCollection(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCollection(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ItemCollection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovCollection(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovCollection(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovCollection(uint64(l))
+	}
+	if m.CreationPolicy != 0 {
+		n += 1 + sovCollection(uint64(m.CreationPolicy))
+	}
+	if len(m.Allowlist) > 0 {
+		for _, s := range m.Allowlist {
+			l = len(s)
+			n += 1 + l + sovCollection(uint64(l))
+		}
+	}
+	if m.MaxItems != 0 {
+		n += 1 + sovCollection(uint64(m.MaxItems))
+	}
+	if m.MaxItemsPerCreator != 0 {
+		n += 1 + sovCollection(uint64(m.MaxItemsPerCreator))
+	}
+	if m.ExpiryAction != 0 {
+		n += 1 + sovCollection(uint64(m.ExpiryAction))
+	}
+	if m.Royalty != nil {
+		l = m.Royalty.Size()
+		n += 1 + l + sovCollection(uint64(l))
+	}
+	if len(m.Attesters) > 0 {
+		for _, s := range m.Attesters {
+			l = len(s)
+			n += 1 + l + sovCollection(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovCollection(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCollection(x uint64) (n int) {
+	return sovCollection(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ItemCollection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCollection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ItemCollection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ItemCollection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationPolicy", wireType)
+			}
+			m.CreationPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationPolicy |= CreationPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxItems", wireType)
+			}
+			m.MaxItems = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxItems |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxItemsPerCreator", wireType)
+			}
+			m.MaxItemsPerCreator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxItemsPerCreator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryAction", wireType)
+			}
+			m.ExpiryAction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryAction |= ExpiryAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Royalty == nil {
+				m.Royalty = &Royalty{}
+			}
+			if err := m.Royalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attesters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attesters = append(m.Attesters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCollection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCollection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCollection(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCollection
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCollection
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCollection
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCollection
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCollection
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCollection        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCollection          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCollection = fmt.Errorf("proto: unexpected end of group")
+)
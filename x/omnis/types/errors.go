@@ -8,5 +8,28 @@ import (
 
 // x/omnis module sentinel errors
 var (
-	ErrInvalidSigner = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidSigner      = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrItemAlreadyExists  = errors.Register(ModuleName, 1101, "item already exists")
+	ErrInvalidAlias       = errors.Register(ModuleName, 1102, "invalid item alias")
+	ErrInvalidAttribute   = errors.Register(ModuleName, 1103, "invalid item attribute")
+	ErrInvalidNamespace   = errors.Register(ModuleName, 1104, "invalid namespace")
+	ErrSchemaViolation    = errors.Register(ModuleName, 1105, "item attributes do not match the collection schema")
+	ErrInvalidCollection  = errors.Register(ModuleName, 1106, "invalid item collection")
+	ErrCollectionExists   = errors.Register(ModuleName, 1107, "item collection already exists")
+	ErrCollectionFull     = errors.Register(ModuleName, 1108, "item collection limit reached")
+	ErrInvalidContentHash = errors.Register(ModuleName, 1109, "invalid content hash")
+	ErrInvalidExpiry      = errors.Register(ModuleName, 1110, "invalid item expiry")
+	ErrItemExpired        = errors.Register(ModuleName, 1111, "item expired")
+	ErrItemListed         = errors.Register(ModuleName, 1112, "item already listed")
+	ErrInvalidPrice       = errors.Register(ModuleName, 1113, "invalid listing price")
+	ErrPriceMismatch      = errors.Register(ModuleName, 1114, "price does not match the listing")
+	ErrInvalidPacket      = errors.Register(ModuleName, 1115, "invalid ICS-721 packet")
+	ErrInvalidVersion     = errors.Register(ModuleName, 1116, "invalid ICS-721 version")
+	ErrInvalidClassTrace  = errors.Register(ModuleName, 1117, "invalid ICS-721 class trace")
+	ErrInvalidRoyalty     = errors.Register(ModuleName, 1118, "invalid royalty")
+	ErrBatchTooLarge      = errors.Register(ModuleName, 1119, "batch too large")
+	ErrInvalidAttestation = errors.Register(ModuleName, 1120, "invalid attestation")
+	ErrInvalidLifecycle   = errors.Register(ModuleName, 1121, "invalid item lifecycle")
+	ErrInvalidTransition  = errors.Register(ModuleName, 1122, "invalid lifecycle transition")
+	ErrInvalidURI         = errors.Register(ModuleName, 1123, "invalid uri")
 )
@@ -4,7 +4,12 @@ import (
 	"context"
 
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/nft"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+
+	tokentypes "omnis/x/token/types"
 )
 
 // AuthKeeper defines the expected interface for the Auth module.
@@ -17,9 +22,45 @@ type AuthKeeper interface {
 // BankKeeper defines the expected interface for the Bank module.
 type BankKeeper interface {
 	SpendableCoins(context.Context, sdk.AccAddress) sdk.Coins
+	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	// Methods imported from bank should be defined here
 }
 
+// NFTKeeper defines the expected interface for the NFT module, which items are
+// mirrored to.
+type NFTKeeper interface {
+	SaveClass(ctx context.Context, class nft.Class) error
+	UpdateClass(ctx context.Context, class nft.Class) error
+	HasClass(ctx context.Context, classID string) bool
+	Mint(ctx context.Context, token nft.NFT, receiver sdk.AccAddress) error
+	Burn(ctx context.Context, classID, nftID string) error
+	Transfer(ctx context.Context, classID, nftID string, receiver sdk.AccAddress) error
+	HasNFT(ctx context.Context, classID, nftID string) bool
+	GetOwner(ctx context.Context, classID, nftID string) sdk.AccAddress
+}
+
+// TokenKeeper defines the expected interface for the token module, which
+// issues the fraction tokens of fractionalized items.
+type TokenKeeper interface {
+	IssueToken(ctx context.Context, issuer string, token tokentypes.Token, recipient sdk.AccAddress) (tokentypes.Token, error)
+	BurnTokenFrom(ctx context.Context, tokenID uint64, holder sdk.AccAddress, amount math.Int) error
+}
+
+// ICS4Wrapper defines the expected interface of the IBC channel keeper, which
+// ICS-721 packets are sent with.
+type ICS4Wrapper interface {
+	SendPacket(
+		ctx sdk.Context,
+		sourcePort string,
+		sourceChannel string,
+		timeoutHeight clienttypes.Height,
+		timeoutTimestamp uint64,
+		data []byte,
+	) (sequence uint64, err error)
+}
+
 // ParamSubspace defines the expected Subspace interface for parameters.
 type ParamSubspace interface {
 	Get(context.Context, []byte, interface{})
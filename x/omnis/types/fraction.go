@@ -0,0 +1,44 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// IsBoughtOut returns whether the item of the fraction was bought out.
+func (f Fraction) IsBoughtOut() bool {
+	return f.Buyer != ""
+}
+
+// Validate performs basic validation of the fraction.
+func (f Fraction) Validate() error {
+	if err := sdk.ValidateDenom(f.Denom); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid fraction denom: %s", err)
+	}
+	if f.Supply.IsNil() || !f.Supply.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "fraction supply must be positive")
+	}
+	if f.Outstanding.IsNil() || !f.Outstanding.IsPositive() || f.Outstanding.GT(f.Supply) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "outstanding fraction supply must be positive and at most the supply")
+	}
+	if _, err := sdk.AccAddressFromBech32(f.Owner); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid fraction owner: %s", err)
+	}
+	if err := f.ReservePrice.Validate(); err != nil || !f.ReservePrice.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidPrice, "reserve price must be positive")
+	}
+	if !f.IsBoughtOut() {
+		if !f.Proceeds.IsNil() && !f.Proceeds.IsZero() {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "proceeds of a fraction that was not bought out")
+		}
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(f.Buyer); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid fraction buyer: %s", err)
+	}
+	if err := f.Proceeds.Validate(); err != nil || f.Proceeds.Denom != f.ReservePrice.Denom {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "proceeds must be in the denom of the reserve price")
+	}
+	return nil
+}
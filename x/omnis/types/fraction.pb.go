@@ -0,0 +1,719 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: omnis/omnis/v1/fraction.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Fraction records an item locked in the module escrow against a fungible
+// token of the x/token registry representing its fractional ownership.
+type Fraction struct {
+	ItemId uint64 `protobuf:"varint,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	// token_id is the id of the fraction token in the x/token registry.
+	TokenId uint64 `protobuf:"varint,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	// denom is the bank denom of the fraction token, its symbol.
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// supply is the amount of fraction tokens issued.
+	Supply cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=supply,proto3,customtype=cosmossdk.io/math.Int" json:"supply"`
+	// owner is the account that fractionalized the item.
+	Owner string `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
+	// reserve_price is the price anyone may buy the item out at.
+	ReservePrice types.Coin `protobuf:"bytes,6,opt,name=reserve_price,json=reservePrice,proto3" json:"reserve_price"`
+	// buyer is the account that bought the item out, if any. The fraction is
+	// kept until the holders have claimed the proceeds.
+	Buyer string `protobuf:"bytes,7,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// proceeds is the part of the buyout price the holders have yet to claim.
+	Proceeds types.Coin `protobuf:"bytes,8,opt,name=proceeds,proto3" json:"proceeds"`
+	// outstanding is the amount of fraction tokens the module has yet to burn
+	// on redemption or claims, the shares of the proceeds are computed against.
+	Outstanding cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=outstanding,proto3,customtype=cosmossdk.io/math.Int" json:"outstanding"`
+}
+
+func (m *Fraction) Reset()         { *m = Fraction{} }
+func (m *Fraction) String() string { return proto.CompactTextString(m) }
+func (*Fraction) ProtoMessage()    {}
+func (*Fraction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9257e1b7b0d79dbe, []int{0}
+}
+func (m *Fraction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Fraction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Fraction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Fraction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Fraction.Merge(m, src)
+}
+func (m *Fraction) XXX_Size() int {
+	return m.Size()
+}
+func (m *Fraction) XXX_DiscardUnknown() {
+	xxx_messageInfo_Fraction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Fraction proto.InternalMessageInfo
+
+func (m *Fraction) GetItemId() uint64 {
+	if m != nil {
+		return m.ItemId
+	}
+	return 0
+}
+
+func (m *Fraction) GetTokenId() uint64 {
+	if m != nil {
+		return m.TokenId
+	}
+	return 0
+}
+
+func (m *Fraction) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *Fraction) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Fraction) GetReservePrice() types.Coin {
+	if m != nil {
+		return m.ReservePrice
+	}
+	return types.Coin{}
+}
+
+func (m *Fraction) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
+}
+
+func (m *Fraction) GetProceeds() types.Coin {
+	if m != nil {
+		return m.Proceeds
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*Fraction)(nil), "omnis.omnis.v1.Fraction")
+}
+
+func init() { proto.RegisterFile("omnis/omnis/v1/fraction.proto", fileDescriptor_9257e1b7b0d79dbe) }
+
+var fileDescriptor_9257e1b7b0d79dbe = []byte{
+	// 415 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0xcd, 0x8a, 0x53, 0x31,
+	0x14, 0x6e, 0x9c, 0xe9, 0x5f, 0x46, 0x05, 0x63, 0xc5, 0xb4, 0xe0, 0x9d, 0xe2, 0xaa, 0x08, 0x4d,
+	0xac, 0xbe, 0x80, 0x56, 0x10, 0xef, 0x4e, 0xae, 0x3b, 0x37, 0xe5, 0xf6, 0x26, 0xd6, 0x30, 0xde,
+	0x9c, 0x4b, 0x92, 0x56, 0xfb, 0x16, 0x3e, 0x86, 0x4b, 0x17, 0xf3, 0x10, 0xb3, 0x1c, 0x66, 0x25,
+	0x2e, 0xaa, 0xb4, 0x0b, 0x5f, 0x43, 0xf2, 0x83, 0xb8, 0x13, 0x67, 0x13, 0xf2, 0x9d, 0xef, 0x7c,
+	0xe7, 0xfb, 0x38, 0x1c, 0xfc, 0x00, 0x6a, 0xad, 0x2c, 0x8f, 0xef, 0x66, 0xc6, 0xdf, 0x99, 0xb2,
+	0x72, 0x0a, 0x34, 0x6b, 0x0c, 0x38, 0x20, 0xb7, 0x03, 0xc1, 0xe2, 0xbb, 0x99, 0x8d, 0xee, 0x94,
+	0xb5, 0xd2, 0xc0, 0xc3, 0x1b, 0x5b, 0x46, 0x59, 0x05, 0xb6, 0x06, 0xcb, 0x97, 0xa5, 0x95, 0x7c,
+	0x33, 0x5b, 0x4a, 0x57, 0xce, 0x78, 0x05, 0x2a, 0x8d, 0x18, 0x0d, 0x23, 0xbf, 0x08, 0x88, 0x47,
+	0x90, 0xa8, 0xc1, 0x0a, 0x56, 0x10, 0xeb, 0xfe, 0x17, 0xab, 0x0f, 0x7f, 0x1c, 0xe1, 0xde, 0xcb,
+	0x14, 0x83, 0xdc, 0xc7, 0x5d, 0xe5, 0x64, 0xbd, 0x50, 0x82, 0xa2, 0x31, 0x9a, 0x1c, 0x17, 0x1d,
+	0x0f, 0x73, 0x41, 0x86, 0xb8, 0xe7, 0xe0, 0x4c, 0x6a, 0xcf, 0xdc, 0x08, 0x4c, 0x37, 0xe0, 0x5c,
+	0x90, 0x01, 0x6e, 0x0b, 0xa9, 0xa1, 0xa6, 0x47, 0x63, 0x34, 0xe9, 0x17, 0x11, 0x90, 0x57, 0xb8,
+	0x63, 0xd7, 0x4d, 0xf3, 0x61, 0x4b, 0x8f, 0x7d, 0x79, 0xfe, 0xf8, 0x62, 0x77, 0xda, 0xfa, 0xbe,
+	0x3b, 0xbd, 0x17, 0x23, 0x59, 0x71, 0xc6, 0x14, 0xf0, 0xba, 0x74, 0xef, 0x59, 0xae, 0xdd, 0xd5,
+	0xf9, 0x14, 0xa7, 0xac, 0xb9, 0x76, 0x5f, 0x7e, 0x7d, 0x7d, 0x84, 0x8a, 0xa4, 0x27, 0x0c, 0xb7,
+	0xe1, 0xa3, 0x96, 0x86, 0xb6, 0xc3, 0x20, 0x7a, 0x75, 0x3e, 0x1d, 0xa4, 0xde, 0xe7, 0x42, 0x18,
+	0x69, 0xed, 0x1b, 0x67, 0x94, 0x5e, 0x15, 0xb1, 0x8d, 0xe4, 0xf8, 0x96, 0x91, 0x56, 0x9a, 0x8d,
+	0x5c, 0x34, 0x46, 0x55, 0x92, 0x76, 0xc6, 0x68, 0x72, 0xf2, 0x64, 0xc8, 0x92, 0xc8, 0x6f, 0x8e,
+	0xa5, 0xcd, 0xb1, 0x17, 0xa0, 0xf4, 0xbc, 0xef, 0xb3, 0x45, 0xd3, 0x9b, 0x49, 0xfa, 0xda, 0x2b,
+	0xbd, 0xf5, 0x72, 0xbd, 0x95, 0x86, 0x76, 0xff, 0x65, 0x1d, 0xda, 0xc8, 0x33, 0xdc, 0x6b, 0x0c,
+	0x54, 0x52, 0x0a, 0x4b, 0x7b, 0xff, 0xe1, 0xfa, 0x47, 0x45, 0x0a, 0x7c, 0x02, 0x6b, 0x67, 0x5d,
+	0xa9, 0x85, 0xd2, 0x2b, 0xda, 0xbf, 0xe6, 0xee, 0xfe, 0x1e, 0x32, 0x9f, 0x5e, 0xec, 0x33, 0x74,
+	0xb9, 0xcf, 0xd0, 0xcf, 0x7d, 0x86, 0x3e, 0x1f, 0xb2, 0xd6, 0xe5, 0x21, 0x6b, 0x7d, 0x3b, 0x64,
+	0xad, 0xb7, 0x77, 0xe3, 0x21, 0x7e, 0x4a, 0x07, 0xe9, 0xb6, 0x8d, 0xb4, 0xcb, 0x4e, 0xb8, 0x8b,
+	0xa7, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xf9, 0xa2, 0x81, 0x85, 0xac, 0x02, 0x00, 0x00,
+}
+
+func (m *Fraction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Fraction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Fraction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Outstanding.Size()
+		i -= size
+		if _, err := m.Outstanding.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFraction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.Proceeds.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFraction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintFraction(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size, err := m.ReservePrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFraction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintFraction(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.Supply.Size()
+		i -= size
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFraction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFraction(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TokenId != 0 {
+		i = encodeVarintFraction(dAtA, i, uint64(m.TokenId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ItemId != 0 {
+		i = encodeVarintFraction(dAtA, i, uint64(m.ItemId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFraction(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFraction(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Fraction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ItemId != 0 {
+		n += 1 + sovFraction(uint64(m.ItemId))
+	}
+	if m.TokenId != 0 {
+		n += 1 + sovFraction(uint64(m.TokenId))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFraction(uint64(l))
+	}
+	l = m.Supply.Size()
+	n += 1 + l + sovFraction(uint64(l))
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovFraction(uint64(l))
+	}
+	l = m.ReservePrice.Size()
+	n += 1 + l + sovFraction(uint64(l))
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovFraction(uint64(l))
+	}
+	l = m.Proceeds.Size()
+	n += 1 + l + sovFraction(uint64(l))
+	l = m.Outstanding.Size()
+	n += 1 + l + sovFraction(uint64(l))
+	return n
+}
+
+func sovFraction(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFraction(x uint64) (n int) {
+	return sovFraction(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Fraction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFraction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Fraction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Fraction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemId", wireType)
+			}
+			m.ItemId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFraction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ItemId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			m.TokenId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFraction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokenId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFraction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFraction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFraction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFraction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFraction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFraction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFraction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFraction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFraction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservePrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFraction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFraction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFraction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReservePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFraction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFraction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFraction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proceeds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFraction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFraction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFraction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proceeds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outstanding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFraction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFraction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFraction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Outstanding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFraction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFraction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFraction(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFraction
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFraction
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFraction
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFraction
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFraction
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFraction
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFraction        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFraction          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFraction = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,14 +1,335 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params: DefaultParams(),
+		Params:               DefaultParams(),
+		ItemList:             []Item{},
+		AttributeSchemaList:  []AttributeSchema{},
+		CollectionList:       []ItemCollection{},
+		ItemRevisionList:     []ItemRevision{},
+		NotarizationList:     []Notarization{},
+		ItemApprovalList:     []ItemApproval{},
+		OperatorApprovalList: []OperatorApproval{},
+		ListingList:          []Listing{},
+		ClassTraceList:       []ClassTrace{},
+		VoucherTokenList:     []VoucherToken{},
+		FractionList:         []Fraction{},
+		AttestationList:      []Attestation{},
+		LifecycleList:        []ItemLifecycle{},
+		ProvenanceList:       []CustodyRecord{},
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	collectionMap := make(map[string]bool)
+	for _, elem := range gs.CollectionList {
+		if collectionMap[elem.Namespace] {
+			return fmt.Errorf("duplicated collection %s", elem.Namespace)
+		}
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		collectionMap[elem.Namespace] = true
+	}
+
+	schemas := make(map[string]*AttributeSchema)
+	for i, elem := range gs.AttributeSchemaList {
+		if _, ok := schemas[elem.Namespace]; ok {
+			return fmt.Errorf("duplicated attribute schema for namespace %s", elem.Namespace)
+		}
+		if !collectionMap[elem.Namespace] {
+			return fmt.Errorf("attribute schema references unknown collection %s", elem.Namespace)
+		}
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		schemas[elem.Namespace] = &gs.AttributeSchemaList[i]
+	}
+
+	lifecycles := make(map[string]ItemLifecycle)
+	for _, elem := range gs.LifecycleList {
+		if _, ok := lifecycles[elem.Namespace]; ok {
+			return fmt.Errorf("duplicated lifecycle for namespace %s", elem.Namespace)
+		}
+		if !collectionMap[elem.Namespace] {
+			return fmt.Errorf("lifecycle references unknown collection %s", elem.Namespace)
+		}
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		lifecycles[elem.Namespace] = elem
+	}
+
+	items := make(map[uint64]Item, len(gs.ItemList))
+	aliasMap := make(map[string]bool)
+	itemCount := gs.GetItemCount()
+	for _, elem := range gs.ItemList {
+		if _, ok := items[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for item")
+		}
+		if elem.Id >= itemCount {
+			return fmt.Errorf("item id should be lower or equal than the last id")
+		}
+		if _, err := sdk.AccAddressFromBech32(elem.Owner); err != nil {
+			return fmt.Errorf("invalid owner of item %d: %w", elem.Id, err)
+		}
+		if _, err := sdk.AccAddressFromBech32(elem.Creator); err != nil {
+			return fmt.Errorf("invalid creator of item %d: %w", elem.Id, err)
+		}
+		if elem.Alias != "" {
+			if err := ValidateItemAlias(elem.Alias); err != nil {
+				return err
+			}
+			aliasKey := elem.Creator + "/" + elem.Alias
+			if aliasMap[aliasKey] {
+				return fmt.Errorf("duplicated alias %s for creator %s", elem.Alias, elem.Creator)
+			}
+			aliasMap[aliasKey] = true
+		}
+		if !collectionMap[elem.Namespace] {
+			return fmt.Errorf("item %d references unknown collection %s", elem.Id, elem.Namespace)
+		}
+		normalized, err := NormalizeAttributes(elem.Attributes)
+		if err != nil {
+			return fmt.Errorf("invalid attributes of item %d: %w", elem.Id, err)
+		}
+		for i, attr := range normalized {
+			if attr.Key != elem.Attributes[i].Key || attr.Value != elem.Attributes[i].Value {
+				return fmt.Errorf("attributes of item %d must be normalized and sorted by key", elem.Id)
+			}
+		}
+		if err := ValidateItemAttributes(elem.Attributes, schemas[elem.Namespace]); err != nil {
+			return fmt.Errorf("invalid attributes of item %d: %w", elem.Id, err)
+		}
+		if elem.ContentHash != nil {
+			if err := elem.ContentHash.Validate(); err != nil {
+				return fmt.Errorf("invalid content hash of item %d: %w", elem.Id, err)
+			}
+		}
+		if err := ValidateItemURI(elem.Uri); err != nil {
+			return fmt.Errorf("invalid uri of item %d: %w", elem.Id, err)
+		}
+		if elem.Expired && elem.ExpiresAt == nil {
+			return fmt.Errorf("item %d expired without an expiry time", elem.Id)
+		}
+		if elem.User != "" {
+			if _, err := sdk.AccAddressFromBech32(elem.User); err != nil {
+				return fmt.Errorf("invalid user of item %d: %w", elem.Id, err)
+			}
+		}
+		if (elem.User == "") != (elem.UserExpiresAt == nil) {
+			return fmt.Errorf("user of item %d must be set with an expiry time", elem.Id)
+		}
+		if elem.Royalty != nil {
+			if err := elem.Royalty.Validate(); err != nil {
+				return fmt.Errorf("invalid royalty of item %d: %w", elem.Id, err)
+			}
+		}
+		if elem.State != "" {
+			if lifecycle, ok := lifecycles[elem.Namespace]; !ok || !lifecycle.HasState(elem.State) {
+				return fmt.Errorf("item %d is in state %s unknown to the lifecycle of collection %s", elem.Id, elem.State, elem.Namespace)
+			}
+		}
+		items[elem.Id] = elem
+	}
+
+	revisionMap := make(map[string]bool)
+	for _, elem := range gs.ItemRevisionList {
+		key := fmt.Sprintf("%d/%d", elem.ItemId, elem.Version)
+		if revisionMap[key] {
+			return fmt.Errorf("duplicated revision %d of item %d", elem.Version, elem.ItemId)
+		}
+		if elem.ItemId >= itemCount {
+			return fmt.Errorf("revision references item %d above the last id", elem.ItemId)
+		}
+		if elem.Version == 0 || elem.Item.Id != elem.ItemId || elem.Item.Version != elem.Version {
+			return fmt.Errorf("invalid revision %d of item %d", elem.Version, elem.ItemId)
+		}
+		// The items of a revision without a current item have been deleted
+		if item, ok := items[elem.ItemId]; ok && elem.Version > item.Version {
+			return fmt.Errorf("revision %d of item %d is newer than the item", elem.Version, elem.ItemId)
+		}
+		revisionMap[key] = true
+	}
+
+	// The records of an item are exported in order, and its chain of custody
+	// ends with its owner unless it was deleted
+	lastCustody := make(map[uint64]CustodyRecord)
+	for _, elem := range gs.ProvenanceList {
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		if elem.ItemId >= itemCount {
+			return fmt.Errorf("custody record references item %d above the last id", elem.ItemId)
+		}
+		last, ok := lastCustody[elem.ItemId]
+		if ok && elem.Sequence <= last.Sequence {
+			return fmt.Errorf("duplicated or unordered custody record %d of item %d", elem.Sequence, elem.ItemId)
+		}
+		lastCustody[elem.ItemId] = elem
+	}
+	for id, last := range lastCustody {
+		if item, ok := items[id]; ok && last.To != item.Owner {
+			return fmt.Errorf("chain of custody of item %d does not end with its owner", id)
+		}
+	}
+
+	notarizationMap := make(map[string]bool)
+	for _, elem := range gs.NotarizationList {
+		if err := elem.ContentHash.Validate(); err != nil {
+			return err
+		}
+		key := elem.ContentHash.IndexKey()
+		if notarizationMap[key] {
+			return fmt.Errorf("duplicated notarization of %s hash %s", elem.ContentHash.Algorithm, elem.ContentHash.Hash)
+		}
+		if elem.ItemId >= itemCount {
+			return fmt.Errorf("notarization references item %d above the last id", elem.ItemId)
+		}
+		if _, err := sdk.AccAddressFromBech32(elem.Notary); err != nil {
+			return fmt.Errorf("invalid notary of %s hash %s: %w", elem.ContentHash.Algorithm, elem.ContentHash.Hash, err)
+		}
+		notarizationMap[key] = true
+	}
+
+	itemApprovalMap := make(map[string]bool)
+	for _, elem := range gs.ItemApprovalList {
+		if _, ok := items[elem.ItemId]; !ok {
+			return fmt.Errorf("approval references unknown item %d", elem.ItemId)
+		}
+		if _, err := sdk.AccAddressFromBech32(elem.Operator); err != nil {
+			return fmt.Errorf("invalid operator of item %d: %w", elem.ItemId, err)
+		}
+		key := fmt.Sprintf("%d/%s", elem.ItemId, elem.Operator)
+		if itemApprovalMap[key] {
+			return fmt.Errorf("duplicated approval of %s for item %d", elem.Operator, elem.ItemId)
+		}
+		itemApprovalMap[key] = true
+	}
+
+	operatorApprovalMap := make(map[string]bool)
+	for _, elem := range gs.OperatorApprovalList {
+		if _, err := sdk.AccAddressFromBech32(elem.Owner); err != nil {
+			return fmt.Errorf("invalid owner of operator approval: %w", err)
+		}
+		if _, err := sdk.AccAddressFromBech32(elem.Operator); err != nil {
+			return fmt.Errorf("invalid operator of %s: %w", elem.Owner, err)
+		}
+		key := elem.Owner + "/" + elem.Operator
+		if operatorApprovalMap[key] {
+			return fmt.Errorf("duplicated approval of operator %s for %s", elem.Operator, elem.Owner)
+		}
+		operatorApprovalMap[key] = true
+	}
+
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	listingMap := make(map[uint64]bool)
+	for _, elem := range gs.ListingList {
+		if listingMap[elem.ItemId] {
+			return fmt.Errorf("duplicated listing of item %d", elem.ItemId)
+		}
+		item, ok := items[elem.ItemId]
+		if !ok {
+			return fmt.Errorf("listing references unknown item %d", elem.ItemId)
+		}
+		if item.Expired {
+			return fmt.Errorf("listing references expired item %d", elem.ItemId)
+		}
+		if elem.Seller != item.Owner || elem.Namespace != item.Namespace {
+			return fmt.Errorf("listing of item %d does not match its owner and collection", elem.ItemId)
+		}
+		if err := gs.Params.ValidateListingPrice(elem.Price); err != nil {
+			return fmt.Errorf("invalid price of listing of item %d: %w", elem.ItemId, err)
+		}
+		listingMap[elem.ItemId] = true
+	}
+
+	classTraceMap := make(map[string]bool)
+	for _, elem := range gs.ClassTraceList {
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		if classTraceMap[elem.IBCClassID()] {
+			return fmt.Errorf("duplicated class trace %s", elem.GetFullClassPath())
+		}
+		if !collectionMap[elem.IBCClassID()] {
+			return fmt.Errorf("class trace %s has no voucher collection", elem.GetFullClassPath())
+		}
+		classTraceMap[elem.IBCClassID()] = true
+	}
+
+	voucherTokenMap := make(map[uint64]bool)
+	voucherTokenIDMap := make(map[string]bool)
+	for _, elem := range gs.VoucherTokenList {
+		if voucherTokenMap[elem.ItemId] {
+			return fmt.Errorf("duplicated voucher token of item %d", elem.ItemId)
+		}
+		item, ok := items[elem.ItemId]
+		if !ok {
+			return fmt.Errorf("voucher token references unknown item %d", elem.ItemId)
+		}
+		if elem.Namespace != item.Namespace || !classTraceMap[elem.Namespace] {
+			return fmt.Errorf("voucher token of item %d does not match a voucher collection", elem.ItemId)
+		}
+		key := elem.Namespace + "/" + elem.TokenId
+		if elem.TokenId == "" || voucherTokenIDMap[key] {
+			return fmt.Errorf("invalid or duplicated token id %q in %s", elem.TokenId, elem.Namespace)
+		}
+		voucherTokenMap[elem.ItemId] = true
+		voucherTokenIDMap[key] = true
+	}
+
+	// Items are held in escrow by the module until they are bought out
+	escrow := sdk.AccAddress(authtypes.NewModuleAddress(ModuleName)).String()
+	fractionMap := make(map[uint64]bool)
+	fractionDenomMap := make(map[string]bool)
+	for _, elem := range gs.FractionList {
+		if fractionMap[elem.ItemId] {
+			return fmt.Errorf("duplicated fraction of item %d", elem.ItemId)
+		}
+		if fractionDenomMap[elem.Denom] {
+			return fmt.Errorf("duplicated fraction denom %s", elem.Denom)
+		}
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid fraction of item %d: %w", elem.ItemId, err)
+		}
+		if !elem.IsBoughtOut() {
+			item, ok := items[elem.ItemId]
+			if !ok || item.Owner != escrow {
+				return fmt.Errorf("fractionalized item %d is not held in escrow", elem.ItemId)
+			}
+		}
+		fractionMap[elem.ItemId] = true
+		fractionDenomMap[elem.Denom] = true
+	}
+
+	attestationMap := make(map[string]bool)
+	for _, elem := range gs.AttestationList {
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid attestation of item %d: %w", elem.ItemId, err)
+		}
+		if _, ok := items[elem.ItemId]; !ok {
+			return fmt.Errorf("attestation of unknown item %d", elem.ItemId)
+		}
+		key := fmt.Sprintf("%d/%s/%s", elem.ItemId, elem.Attester, elem.ClaimType)
+		if attestationMap[key] {
+			return fmt.Errorf("duplicated %s attestation of item %d by %s", elem.ClaimType, elem.ItemId, elem.Attester)
+		}
+		attestationMap[key] = true
+	}
+
+	return nil
 }
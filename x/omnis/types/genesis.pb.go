@@ -28,6 +28,39 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// item_list holds all items, ordered by id. Aliases and the owner index are
+	// rebuilt from it.
+	ItemList []Item `protobuf:"bytes,2,rep,name=item_list,json=itemList,proto3" json:"item_list"`
+	// item_count is the next item id.
+	ItemCount           uint64            `protobuf:"varint,3,opt,name=item_count,json=itemCount,proto3" json:"item_count,omitempty"`
+	AttributeSchemaList []AttributeSchema `protobuf:"bytes,4,rep,name=attribute_schema_list,json=attributeSchemaList,proto3" json:"attribute_schema_list"`
+	// collection_list holds all item collections. Item counts are rebuilt from
+	// item_list.
+	CollectionList []ItemCollection `protobuf:"bytes,5,rep,name=collection_list,json=collectionList,proto3" json:"collection_list"`
+	// item_revision_list holds the retained history of all items, including
+	// deleted ones.
+	ItemRevisionList []ItemRevision `protobuf:"bytes,6,rep,name=item_revision_list,json=itemRevisionList,proto3" json:"item_revision_list"`
+	// notarization_list holds the first registration of every content hash. The
+	// content hash index of items is rebuilt from item_list.
+	NotarizationList     []Notarization     `protobuf:"bytes,7,rep,name=notarization_list,json=notarizationList,proto3" json:"notarization_list"`
+	ItemApprovalList     []ItemApproval     `protobuf:"bytes,8,rep,name=item_approval_list,json=itemApprovalList,proto3" json:"item_approval_list"`
+	OperatorApprovalList []OperatorApproval `protobuf:"bytes,9,rep,name=operator_approval_list,json=operatorApprovalList,proto3" json:"operator_approval_list"`
+	// listing_list holds the open marketplace listings. The collection and
+	// seller indexes are rebuilt from it.
+	ListingList    []Listing    `protobuf:"bytes,10,rep,name=listing_list,json=listingList,proto3" json:"listing_list"`
+	ClassTraceList []ClassTrace `protobuf:"bytes,11,rep,name=class_trace_list,json=classTraceList,proto3" json:"class_trace_list"`
+	// voucher_token_list maps the items received over ICS-721 to their token
+	// ids.
+	VoucherTokenList []VoucherToken `protobuf:"bytes,12,rep,name=voucher_token_list,json=voucherTokenList,proto3" json:"voucher_token_list"`
+	// fraction_list holds the fractionalized items.
+	FractionList []Fraction `protobuf:"bytes,13,rep,name=fraction_list,json=fractionList,proto3" json:"fraction_list"`
+	// attestation_list holds the attestations of the items.
+	AttestationList []Attestation `protobuf:"bytes,14,rep,name=attestation_list,json=attestationList,proto3" json:"attestation_list"`
+	// lifecycle_list holds the lifecycles of the collections.
+	LifecycleList []ItemLifecycle `protobuf:"bytes,15,rep,name=lifecycle_list,json=lifecycleList,proto3" json:"lifecycle_list"`
+	// provenance_list holds the chains of custody of the items, including the
+	// deleted ones.
+	ProvenanceList []CustodyRecord `protobuf:"bytes,16,rep,name=provenance_list,json=provenanceList,proto3" json:"provenance_list"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -70,6 +103,111 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetItemList() []Item {
+	if m != nil {
+		return m.ItemList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetItemCount() uint64 {
+	if m != nil {
+		return m.ItemCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetAttributeSchemaList() []AttributeSchema {
+	if m != nil {
+		return m.AttributeSchemaList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCollectionList() []ItemCollection {
+	if m != nil {
+		return m.CollectionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetItemRevisionList() []ItemRevision {
+	if m != nil {
+		return m.ItemRevisionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNotarizationList() []Notarization {
+	if m != nil {
+		return m.NotarizationList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetItemApprovalList() []ItemApproval {
+	if m != nil {
+		return m.ItemApprovalList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOperatorApprovalList() []OperatorApproval {
+	if m != nil {
+		return m.OperatorApprovalList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetListingList() []Listing {
+	if m != nil {
+		return m.ListingList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetClassTraceList() []ClassTrace {
+	if m != nil {
+		return m.ClassTraceList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVoucherTokenList() []VoucherToken {
+	if m != nil {
+		return m.VoucherTokenList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFractionList() []Fraction {
+	if m != nil {
+		return m.FractionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAttestationList() []Attestation {
+	if m != nil {
+		return m.AttestationList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLifecycleList() []ItemLifecycle {
+	if m != nil {
+		return m.LifecycleList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProvenanceList() []CustodyRecord {
+	if m != nil {
+		return m.ProvenanceList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "omnis.omnis.v1.GenesisState")
 }
@@ -77,19 +215,50 @@ func init() {
 func init() { proto.RegisterFile("omnis/omnis/v1/genesis.proto", fileDescriptor_b24bab5237a20f19) }
 
 var fileDescriptor_b24bab5237a20f19 = []byte{
-	// 185 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xc9, 0xcf, 0xcd, 0xcb,
-	0x2c, 0xd6, 0x87, 0x90, 0x65, 0x86, 0xfa, 0xe9, 0xa9, 0x79, 0xa9, 0xc5, 0x99, 0xc5, 0x7a, 0x05,
-	0x45, 0xf9, 0x25, 0xf9, 0x42, 0x7c, 0x60, 0x71, 0x3d, 0x08, 0x59, 0x66, 0x28, 0x25, 0x98, 0x98,
-	0x9b, 0x99, 0x97, 0xaf, 0x0f, 0x26, 0x21, 0x4a, 0xa4, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x4c,
-	0x7d, 0x10, 0x0b, 0x2a, 0x2a, 0x8d, 0x66, 0x6c, 0x41, 0x62, 0x51, 0x62, 0x2e, 0xd4, 0x54, 0x25,
-	0x4f, 0x2e, 0x1e, 0x77, 0x88, 0x35, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42, 0x96, 0x5c, 0x6c, 0x10,
-	0x79, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x31, 0x3d, 0x54, 0x6b, 0xf5, 0x02, 0xc0, 0xb2,
-	0x4e, 0x9c, 0x27, 0xee, 0xc9, 0x33, 0xac, 0x78, 0xbe, 0x41, 0x8b, 0x31, 0x08, 0xaa, 0xc1, 0x49,
-	0xf7, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58,
-	0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x84, 0x21, 0x76, 0x57, 0x40,
-	0xdd, 0x50, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x76, 0x80, 0x31, 0x20, 0x00, 0x00, 0xff,
-	0xff, 0x2b, 0xaf, 0xa0, 0xa9, 0xf6, 0x00, 0x00, 0x00,
+	// 680 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xcf, 0x6e, 0x13, 0x3f,
+	0x10, 0xc7, 0xb3, 0xbf, 0xf6, 0x57, 0x1a, 0x27, 0x4d, 0xd2, 0xb4, 0x94, 0xd0, 0x3f, 0xdb, 0xc0,
+	0xa9, 0x42, 0x22, 0x51, 0xcb, 0xa1, 0xe2, 0x46, 0x1b, 0x09, 0x04, 0x0a, 0xb4, 0x4a, 0x2b, 0x24,
+	0x10, 0x52, 0xe4, 0xba, 0x6e, 0x6a, 0x75, 0x77, 0xbd, 0xb2, 0x9d, 0x88, 0xf0, 0x14, 0x3c, 0x06,
+	0x12, 0x17, 0x1e, 0xa3, 0xc7, 0x1e, 0x39, 0x21, 0xd4, 0x1e, 0x78, 0x0d, 0xb4, 0x63, 0x7b, 0xb3,
+	0x31, 0x9b, 0xcb, 0x6a, 0x35, 0xf3, 0xfd, 0x7e, 0x3c, 0x9e, 0xd9, 0x1d, 0xb4, 0xc9, 0xc3, 0x88,
+	0xc9, 0xb6, 0x7e, 0x8e, 0x76, 0xdb, 0x03, 0x1a, 0x51, 0xc9, 0x64, 0x2b, 0x16, 0x5c, 0xf1, 0x7a,
+	0x05, 0xe2, 0x2d, 0xfd, 0x1c, 0xed, 0xae, 0x2f, 0xe3, 0x90, 0x45, 0xbc, 0x0d, 0x4f, 0x2d, 0x59,
+	0x5f, 0x1d, 0xf0, 0x01, 0x87, 0xd7, 0x76, 0xf2, 0x66, 0xa2, 0x5b, 0x0e, 0x16, 0xc7, 0xb1, 0xe0,
+	0x23, 0x1c, 0x98, 0x74, 0xd3, 0x4d, 0x2b, 0x45, 0xa5, 0xc2, 0x8a, 0xf1, 0xc8, 0x28, 0xfc, 0x7f,
+	0x15, 0x82, 0x9d, 0x0d, 0x15, 0x35, 0xf9, 0x6d, 0x27, 0x4f, 0x78, 0x10, 0x50, 0x92, 0x01, 0xb8,
+	0x15, 0x5c, 0x08, 0x9c, 0x4d, 0xbb, 0xf7, 0xbe, 0x64, 0x52, 0x71, 0x31, 0x36, 0xd9, 0x0d, 0x27,
+	0xcb, 0x88, 0xdc, 0xdf, 0xdb, 0x35, 0xc9, 0x87, 0x6e, 0x52, 0xd1, 0x70, 0x46, 0xd5, 0x01, 0xbb,
+	0xa0, 0x64, 0x4c, 0x02, 0x3a, 0xe3, 0xde, 0x21, 0x16, 0x57, 0x54, 0xc5, 0x01, 0x26, 0x56, 0xf1,
+	0xc8, 0x51, 0x44, 0x5c, 0x61, 0xc1, 0xbe, 0x64, 0x5b, 0xe3, 0x16, 0x17, 0x63, 0x81, 0x43, 0x39,
+	0xa3, 0x2f, 0x49, 0xdb, 0x69, 0x84, 0x23, 0x7b, 0xc0, 0xe3, 0xef, 0x45, 0x54, 0x7e, 0xa5, 0x87,
+	0x7c, 0xa2, 0xb0, 0xa2, 0xf5, 0xe7, 0x68, 0x41, 0x13, 0x1a, 0x5e, 0xd3, 0xdb, 0x29, 0xed, 0xad,
+	0xb5, 0xa6, 0x87, 0xde, 0x3a, 0x86, 0xec, 0x61, 0xf1, 0xfa, 0xd7, 0x76, 0xe1, 0xdb, 0x9f, 0x1f,
+	0x4f, 0xbc, 0x9e, 0x31, 0xd4, 0xf7, 0x51, 0x31, 0xb9, 0x7c, 0x3f, 0x60, 0x52, 0x35, 0xfe, 0x6b,
+	0xce, 0xed, 0x94, 0xf6, 0x56, 0x5d, 0xf7, 0x6b, 0x45, 0xc3, 0xc3, 0xf9, 0xc4, 0xdb, 0x5b, 0x4c,
+	0xc4, 0x5d, 0x26, 0x55, 0x7d, 0x0b, 0x21, 0x30, 0x12, 0x3e, 0x8c, 0x54, 0x63, 0xae, 0xe9, 0xed,
+	0xcc, 0xf7, 0x00, 0xd5, 0x49, 0x02, 0xf5, 0x0f, 0xe8, 0x7e, 0x3a, 0xef, 0xbe, 0x24, 0x97, 0x34,
+	0xc4, 0xfa, 0x8c, 0x79, 0x38, 0x63, 0xdb, 0x3d, 0xe3, 0xc0, 0x8a, 0x4f, 0x40, 0x6b, 0x8e, 0x5b,
+	0xc1, 0xd3, 0x61, 0x38, 0xf9, 0x2d, 0xaa, 0x4e, 0x3e, 0x15, 0x0d, 0xfd, 0x1f, 0xa0, 0x7e, 0x5e,
+	0xe1, 0x9d, 0x54, 0x6a, 0x98, 0x95, 0x89, 0x19, 0x70, 0xc7, 0xa8, 0x0e, 0x17, 0x11, 0x74, 0xc4,
+	0x64, 0x4a, 0x5c, 0x00, 0xe2, 0x66, 0x1e, 0xb1, 0x67, 0x84, 0x86, 0x57, 0x63, 0x99, 0x18, 0x10,
+	0x8f, 0xd0, 0x72, 0x76, 0xe6, 0x1a, 0x78, 0x2f, 0x1f, 0xf8, 0x2e, 0x23, 0xb4, 0xc0, 0xac, 0x79,
+	0xaa, 0x44, 0xfb, 0x0b, 0x6a, 0xe2, 0xe2, 0xec, 0x12, 0x0f, 0x8c, 0x30, 0x5b, 0xa2, 0x8d, 0x01,
+	0xf1, 0x13, 0x5a, 0xe3, 0x31, 0x15, 0x58, 0x71, 0xe1, 0x50, 0x8b, 0x40, 0x6d, 0xba, 0xd4, 0x23,
+	0xa3, 0x76, 0xc8, 0xab, 0xdc, 0x89, 0x03, 0xfd, 0x05, 0x2a, 0x27, 0x2c, 0x16, 0x0d, 0x34, 0x13,
+	0x01, 0xf3, 0x81, 0xcb, 0xec, 0x6a, 0x8d, 0x41, 0x95, 0x8c, 0x05, 0x08, 0x6f, 0x50, 0x8d, 0x04,
+	0x58, 0xca, 0xbe, 0x12, 0x98, 0x50, 0x4d, 0x29, 0x01, 0x65, 0xdd, 0xa5, 0x74, 0x12, 0xdd, 0x69,
+	0x22, 0x4b, 0x07, 0x9c, 0x46, 0x6c, 0xf7, 0x46, 0x7c, 0x48, 0x2e, 0xa9, 0xe8, 0x2b, 0x7e, 0x45,
+	0xcd, 0x3c, 0xca, 0xf9, 0xdd, 0x7b, 0xaf, 0x95, 0xa7, 0x89, 0xd0, 0x76, 0x6f, 0x94, 0x89, 0x01,
+	0xb1, 0x83, 0x96, 0xec, 0x2e, 0xd2, 0xb0, 0x25, 0x80, 0x35, 0x5c, 0xd8, 0x4b, 0x23, 0x32, 0xa0,
+	0xb2, 0x35, 0x01, 0xa4, 0x8b, 0x6a, 0x99, 0x9d, 0xa9, 0x39, 0x15, 0xe0, 0x6c, 0xe4, 0xfc, 0x1c,
+	0x56, 0x67, 0x50, 0xd5, 0x8c, 0xd5, 0x34, 0xac, 0x92, 0x6e, 0x2a, 0xcd, 0xaa, 0x02, 0x6b, 0x2b,
+	0xef, 0xf3, 0xe8, 0x5a, 0xa5, 0xa1, 0x2d, 0xa5, 0x56, 0x53, 0x59, 0x75, 0xb2, 0x73, 0x34, 0xac,
+	0x96, 0x0f, 0xeb, 0x0c, 0xa5, 0xe2, 0xe7, 0xe3, 0x1e, 0x25, 0x5c, 0x9c, 0xdb, 0xf6, 0x4f, 0xbc,
+	0x09, 0xed, 0xf0, 0xe9, 0xf5, 0xad, 0xef, 0xdd, 0xdc, 0xfa, 0xde, 0xef, 0x5b, 0xdf, 0xfb, 0x7a,
+	0xe7, 0x17, 0x6e, 0xee, 0xfc, 0xc2, 0xcf, 0x3b, 0xbf, 0xf0, 0x71, 0x45, 0xaf, 0xb8, 0xcf, 0x66,
+	0xd5, 0xa9, 0x71, 0x4c, 0xe5, 0xd9, 0x02, 0xec, 0xb8, 0x67, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff,
+	0xd6, 0x58, 0xd6, 0x52, 0xd6, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -112,6 +281,209 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ProvenanceList) > 0 {
+		for iNdEx := len(m.ProvenanceList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProvenanceList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.LifecycleList) > 0 {
+		for iNdEx := len(m.LifecycleList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LifecycleList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.AttestationList) > 0 {
+		for iNdEx := len(m.AttestationList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AttestationList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.FractionList) > 0 {
+		for iNdEx := len(m.FractionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FractionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.VoucherTokenList) > 0 {
+		for iNdEx := len(m.VoucherTokenList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoucherTokenList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ClassTraceList) > 0 {
+		for iNdEx := len(m.ClassTraceList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClassTraceList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ListingList) > 0 {
+		for iNdEx := len(m.ListingList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ListingList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.OperatorApprovalList) > 0 {
+		for iNdEx := len(m.OperatorApprovalList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OperatorApprovalList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ItemApprovalList) > 0 {
+		for iNdEx := len(m.ItemApprovalList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ItemApprovalList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.NotarizationList) > 0 {
+		for iNdEx := len(m.NotarizationList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NotarizationList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ItemRevisionList) > 0 {
+		for iNdEx := len(m.ItemRevisionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ItemRevisionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.CollectionList) > 0 {
+		for iNdEx := len(m.CollectionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CollectionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AttributeSchemaList) > 0 {
+		for iNdEx := len(m.AttributeSchemaList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AttributeSchemaList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ItemCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ItemCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ItemList) > 0 {
+		for iNdEx := len(m.ItemList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ItemList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -144,6 +516,93 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ItemList) > 0 {
+		for _, e := range m.ItemList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ItemCount != 0 {
+		n += 1 + sovGenesis(uint64(m.ItemCount))
+	}
+	if len(m.AttributeSchemaList) > 0 {
+		for _, e := range m.AttributeSchemaList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CollectionList) > 0 {
+		for _, e := range m.CollectionList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ItemRevisionList) > 0 {
+		for _, e := range m.ItemRevisionList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NotarizationList) > 0 {
+		for _, e := range m.NotarizationList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ItemApprovalList) > 0 {
+		for _, e := range m.ItemApprovalList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OperatorApprovalList) > 0 {
+		for _, e := range m.OperatorApprovalList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ListingList) > 0 {
+		for _, e := range m.ListingList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ClassTraceList) > 0 {
+		for _, e := range m.ClassTraceList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VoucherTokenList) > 0 {
+		for _, e := range m.VoucherTokenList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FractionList) > 0 {
+		for _, e := range m.FractionList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AttestationList) > 0 {
+		for _, e := range m.AttestationList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LifecycleList) > 0 {
+		for _, e := range m.LifecycleList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProvenanceList) > 0 {
+		for _, e := range m.ProvenanceList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -215,6 +674,501 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ItemList = append(m.ItemList, Item{})
+			if err := m.ItemList[len(m.ItemList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemCount", wireType)
+			}
+			m.ItemCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ItemCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeSchemaList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttributeSchemaList = append(m.AttributeSchemaList, AttributeSchema{})
+			if err := m.AttributeSchemaList[len(m.AttributeSchemaList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollectionList = append(m.CollectionList, ItemCollection{})
+			if err := m.CollectionList[len(m.CollectionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemRevisionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ItemRevisionList = append(m.ItemRevisionList, ItemRevision{})
+			if err := m.ItemRevisionList[len(m.ItemRevisionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotarizationList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NotarizationList = append(m.NotarizationList, Notarization{})
+			if err := m.NotarizationList[len(m.NotarizationList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemApprovalList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ItemApprovalList = append(m.ItemApprovalList, ItemApproval{})
+			if err := m.ItemApprovalList[len(m.ItemApprovalList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorApprovalList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorApprovalList = append(m.OperatorApprovalList, OperatorApproval{})
+			if err := m.OperatorApprovalList[len(m.OperatorApprovalList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListingList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ListingList = append(m.ListingList, Listing{})
+			if err := m.ListingList[len(m.ListingList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassTraceList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassTraceList = append(m.ClassTraceList, ClassTrace{})
+			if err := m.ClassTraceList[len(m.ClassTraceList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoucherTokenList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoucherTokenList = append(m.VoucherTokenList, VoucherToken{})
+			if err := m.VoucherTokenList[len(m.VoucherTokenList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FractionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FractionList = append(m.FractionList, Fraction{})
+			if err := m.FractionList[len(m.FractionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttestationList = append(m.AttestationList, Attestation{})
+			if err := m.AttestationList[len(m.AttestationList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LifecycleList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LifecycleList = append(m.LifecycleList, ItemLifecycle{})
+			if err := m.LifecycleList[len(m.LifecycleList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProvenanceList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProvenanceList = append(m.ProvenanceList, CustodyRecord{})
+			if err := m.ProvenanceList[len(m.ProvenanceList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,14 +1,20 @@
 package types_test
 
 import (
+	"strings"
 	"testing"
 
 	"omnis/x/omnis/types"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	owner := sdk.AccAddress([]byte("ownerAddr___________________")).String()
+	collections := []types.ItemCollection{{Namespace: "default", Admin: owner, CreationPolicy: types.CREATION_POLICY_OPEN}}
+
 	tests := []struct {
 		desc     string
 		genState *types.GenesisState
@@ -20,9 +26,147 @@ func TestGenesisState_Validate(t *testing.T) {
 			valid:    true,
 		},
 		{
-			desc:     "valid genesis state",
-			genState: &types.GenesisState{},
-			valid:    true,
+			desc: "valid genesis state",
+			genState: &types.GenesisState{
+				Params:         types.DefaultParams(),
+				ItemList:       []types.Item{{Id: 0, Owner: owner, Creator: owner, Alias: "a", Namespace: "default"}, {Id: 1, Owner: owner, Creator: owner, Namespace: "default"}},
+				CollectionList: collections,
+				ItemCount:      2,
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated item",
+			genState: &types.GenesisState{
+				ItemList:       []types.Item{{Id: 0, Owner: owner, Creator: owner, Namespace: "default"}, {Id: 0, Owner: owner, Creator: owner, Namespace: "default"}},
+				CollectionList: collections,
+				ItemCount:      2,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid item count",
+			genState: &types.GenesisState{
+				ItemList:       []types.Item{{Id: 1, Owner: owner, Creator: owner, Namespace: "default"}},
+				CollectionList: collections,
+				ItemCount:      0,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid owner",
+			genState: &types.GenesisState{
+				ItemList:       []types.Item{{Id: 0, Owner: "invalid", Creator: owner, Namespace: "default"}},
+				CollectionList: collections,
+				ItemCount:      1,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated alias",
+			genState: &types.GenesisState{
+				ItemList:       []types.Item{{Id: 0, Owner: owner, Creator: owner, Alias: "a", Namespace: "default"}, {Id: 1, Owner: owner, Creator: owner, Alias: "a", Namespace: "default"}},
+				CollectionList: collections,
+				ItemCount:      2,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid royalty",
+			genState: &types.GenesisState{
+				ItemList:       []types.Item{{Id: 0, Owner: owner, Creator: owner, Namespace: "default", Royalty: &types.Royalty{Recipient: owner}}},
+				CollectionList: collections,
+				ItemCount:      1,
+			},
+			valid: false,
+		},
+		{
+			desc: "fractionalized item not in escrow",
+			genState: &types.GenesisState{
+				ItemList:       []types.Item{{Id: 0, Owner: owner, Creator: owner, Namespace: "default"}},
+				CollectionList: collections,
+				ItemCount:      1,
+				FractionList: []types.Fraction{{
+					ItemId:       0,
+					Denom:        "frac",
+					Supply:       math.NewInt(100),
+					Outstanding:  math.NewInt(100),
+					Owner:        owner,
+					ReservePrice: sdk.NewInt64Coin("oms", 1000),
+				}},
+			},
+			valid: false,
+		},
+		{
+			desc: "attestation of an unknown item",
+			genState: &types.GenesisState{
+				ItemList:       []types.Item{{Id: 0, Owner: owner, Creator: owner, Namespace: "default"}},
+				CollectionList: collections,
+				ItemCount:      1,
+				AttestationList: []types.Attestation{{
+					ItemId:      1,
+					Attester:    owner,
+					ClaimType:   "authentic",
+					PayloadHash: types.ContentHash{Algorithm: types.HASH_ALGORITHM_SHA256, Hash: strings.Repeat("ab", 32)},
+				}},
+			},
+			valid: false,
+		},
+		{
+			desc: "item in a state unknown to the lifecycle",
+			genState: &types.GenesisState{
+				ItemList:       []types.Item{{Id: 0, Owner: owner, Creator: owner, Namespace: "default", State: "lost"}},
+				CollectionList: collections,
+				ItemCount:      1,
+				LifecycleList: []types.ItemLifecycle{{
+					Namespace:    "default",
+					States:       []string{"created", "shipped"},
+					InitialState: "created",
+				}},
+			},
+			valid: false,
+		},
+		{
+			desc: "chain of custody not ending with the owner",
+			genState: &types.GenesisState{
+				ItemList:       []types.Item{{Id: 0, Owner: owner, Creator: owner, Namespace: "default"}},
+				CollectionList: collections,
+				ItemCount:      1,
+				ProvenanceList: []types.CustodyRecord{
+					{ItemId: 0, Sequence: 0, To: owner, Reason: types.CUSTODY_REASON_CREATED},
+					{ItemId: 0, Sequence: 1, From: owner, Reason: types.CUSTODY_REASON_IBC_SEND},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "revision newer than the item",
+			genState: &types.GenesisState{
+				Params:         types.DefaultParams(),
+				ItemList:       []types.Item{{Id: 0, Owner: owner, Creator: owner, Namespace: "default", Version: 1}},
+				ItemCount:      1,
+				CollectionList: collections,
+				ItemRevisionList: []types.ItemRevision{
+					{ItemId: 0, Version: 2, Item: types.Item{Id: 0, Version: 2}},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "unknown collection",
+			genState: &types.GenesisState{
+				ItemList:       []types.Item{{Id: 0, Owner: owner, Creator: owner, Namespace: "other"}},
+				ItemCount:      1,
+				CollectionList: collections,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated collection",
+			genState: &types.GenesisState{
+				CollectionList: append(collections, collections...),
+			},
+			valid: false,
 		},
 	}
 	for _, tc := range tests {
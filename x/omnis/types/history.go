@@ -0,0 +1,92 @@
+package types
+
+import "time"
+
+// Item field names recorded in ItemRevision.ChangedFields.
+const (
+	ItemFieldName          = "name"
+	ItemFieldOwner         = "owner"
+	ItemFieldCreator       = "creator"
+	ItemFieldAlias         = "alias"
+	ItemFieldNamespace     = "namespace"
+	ItemFieldAttributes    = "attributes"
+	ItemFieldContentHash   = "content_hash"
+	ItemFieldURI           = "uri"
+	ItemFieldExpiresAt     = "expires_at"
+	ItemFieldExpired       = "expired"
+	ItemFieldUser          = "user"
+	ItemFieldUserExpiresAt = "user_expires_at"
+	ItemFieldState         = "state"
+)
+
+// ItemChangedFields returns the names of the fields that differ between two
+// states of an item. The id and version are not compared.
+func ItemChangedFields(prev, next Item) []string {
+	var fields []string
+	if prev.Name != next.Name {
+		fields = append(fields, ItemFieldName)
+	}
+	if prev.Owner != next.Owner {
+		fields = append(fields, ItemFieldOwner)
+	}
+	if prev.Creator != next.Creator {
+		fields = append(fields, ItemFieldCreator)
+	}
+	if prev.Alias != next.Alias {
+		fields = append(fields, ItemFieldAlias)
+	}
+	if prev.Namespace != next.Namespace {
+		fields = append(fields, ItemFieldNamespace)
+	}
+	if !attributesEqual(prev.Attributes, next.Attributes) {
+		fields = append(fields, ItemFieldAttributes)
+	}
+	if !contentHashEqual(prev.ContentHash, next.ContentHash) {
+		fields = append(fields, ItemFieldContentHash)
+	}
+	if prev.Uri != next.Uri {
+		fields = append(fields, ItemFieldURI)
+	}
+	if !timeEqual(prev.ExpiresAt, next.ExpiresAt) {
+		fields = append(fields, ItemFieldExpiresAt)
+	}
+	if prev.Expired != next.Expired {
+		fields = append(fields, ItemFieldExpired)
+	}
+	if prev.User != next.User {
+		fields = append(fields, ItemFieldUser)
+	}
+	if !timeEqual(prev.UserExpiresAt, next.UserExpiresAt) {
+		fields = append(fields, ItemFieldUserExpiresAt)
+	}
+	if prev.State != next.State {
+		fields = append(fields, ItemFieldState)
+	}
+	return fields
+}
+
+func attributesEqual(a, b []Attribute) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Key != b[i].Key || a[i].Type != b[i].Type || a[i].Value != b[i].Value {
+			return false
+		}
+	}
+	return true
+}
+
+func contentHashEqual(a, b *ContentHash) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Algorithm == b.Algorithm && a.Hash == b.Hash
+}
+
+func timeEqual(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
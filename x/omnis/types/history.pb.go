@@ -0,0 +1,601 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: omnis/omnis/v1/history.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ItemRevision is an entry of the append-only version log of an item.
+type ItemRevision struct {
+	ItemId uint64 `protobuf:"varint,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	// version is the version of the item this revision produced, starting at 1
+	// for the creation.
+	Version uint64    `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Height  int64     `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Time    time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+	// editor is the account that made the change, empty for changes made by
+	// the module itself such as expiry.
+	Editor string `protobuf:"bytes,5,opt,name=editor,proto3" json:"editor,omitempty"`
+	// changed_fields lists the item fields the revision changed.
+	ChangedFields []string `protobuf:"bytes,6,rep,name=changed_fields,json=changedFields,proto3" json:"changed_fields,omitempty"`
+	// item is the state of the item after the revision.
+	Item Item `protobuf:"bytes,7,opt,name=item,proto3" json:"item"`
+}
+
+func (m *ItemRevision) Reset()         { *m = ItemRevision{} }
+func (m *ItemRevision) String() string { return proto.CompactTextString(m) }
+func (*ItemRevision) ProtoMessage()    {}
+func (*ItemRevision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_745fa5e460a81c95, []int{0}
+}
+func (m *ItemRevision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ItemRevision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ItemRevision.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ItemRevision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ItemRevision.Merge(m, src)
+}
+func (m *ItemRevision) XXX_Size() int {
+	return m.Size()
+}
+func (m *ItemRevision) XXX_DiscardUnknown() {
+	xxx_messageInfo_ItemRevision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ItemRevision proto.InternalMessageInfo
+
+func (m *ItemRevision) GetItemId() uint64 {
+	if m != nil {
+		return m.ItemId
+	}
+	return 0
+}
+
+func (m *ItemRevision) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ItemRevision) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ItemRevision) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *ItemRevision) GetEditor() string {
+	if m != nil {
+		return m.Editor
+	}
+	return ""
+}
+
+func (m *ItemRevision) GetChangedFields() []string {
+	if m != nil {
+		return m.ChangedFields
+	}
+	return nil
+}
+
+func (m *ItemRevision) GetItem() Item {
+	if m != nil {
+		return m.Item
+	}
+	return Item{}
+}
+
+func init() {
+	proto.RegisterType((*ItemRevision)(nil), "omnis.omnis.v1.ItemRevision")
+}
+
+func init() { proto.RegisterFile("omnis/omnis/v1/history.proto", fileDescriptor_745fa5e460a81c95) }
+
+var fileDescriptor_745fa5e460a81c95 = []byte{
+	// 323 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x50, 0xb1, 0x4e, 0xc3, 0x30,
+	0x14, 0x8c, 0xdb, 0x90, 0x52, 0x03, 0x1d, 0x4c, 0x05, 0xa6, 0x42, 0x6e, 0x84, 0x84, 0x94, 0x05,
+	0x47, 0x85, 0x85, 0xb9, 0x03, 0x52, 0x57, 0x8b, 0x89, 0xa5, 0x6a, 0x89, 0xeb, 0x58, 0x6a, 0xea,
+	0x2a, 0x36, 0x11, 0x5d, 0xf9, 0x82, 0x7e, 0x56, 0xc7, 0x8e, 0x4c, 0x80, 0xda, 0x1f, 0x41, 0xb6,
+	0xd3, 0x01, 0x96, 0xa7, 0xdc, 0xbb, 0x8b, 0xef, 0xde, 0xc1, 0x6b, 0x55, 0x2c, 0xa4, 0x4e, 0xfd,
+	0xac, 0x06, 0x69, 0x2e, 0xb5, 0x51, 0xe5, 0x8a, 0x2e, 0x4b, 0x65, 0x14, 0xea, 0xb8, 0x3d, 0xf5,
+	0xb3, 0x1a, 0xf4, 0xba, 0x42, 0x09, 0xe5, 0xa8, 0xd4, 0x7e, 0x79, 0x55, 0xaf, 0x2f, 0x94, 0x12,
+	0x73, 0x9e, 0x3a, 0x34, 0x7d, 0x9b, 0xa5, 0x46, 0x16, 0x5c, 0x9b, 0x49, 0xb1, 0xac, 0x05, 0x57,
+	0xff, 0x4c, 0xa4, 0xe1, 0x85, 0xa7, 0x6e, 0x3e, 0x1a, 0xf0, 0x74, 0x64, 0x78, 0xc1, 0x78, 0x25,
+	0xb5, 0x54, 0x0b, 0x74, 0x09, 0x5b, 0x96, 0x1e, 0xcb, 0x0c, 0x83, 0x18, 0x24, 0x21, 0x8b, 0x2c,
+	0x1c, 0x65, 0x08, 0xc3, 0x56, 0xc5, 0x4b, 0xab, 0xc1, 0x0d, 0x47, 0x1c, 0x20, 0xba, 0x80, 0x51,
+	0xce, 0xa5, 0xc8, 0x0d, 0x6e, 0xc6, 0x20, 0x69, 0xb2, 0x1a, 0xa1, 0x47, 0x18, 0xda, 0x24, 0x38,
+	0x8c, 0x41, 0x72, 0x72, 0xdf, 0xa3, 0x3e, 0x26, 0x3d, 0xc4, 0xa4, 0xcf, 0x87, 0x98, 0xc3, 0xe3,
+	0xcd, 0x57, 0x3f, 0x58, 0x7f, 0xf7, 0x01, 0x73, 0x7f, 0xd8, 0x17, 0x79, 0x26, 0x8d, 0x2a, 0xf1,
+	0x51, 0x0c, 0x92, 0x36, 0xab, 0x11, 0xba, 0x85, 0x9d, 0xd7, 0x7c, 0xb2, 0x10, 0x3c, 0x1b, 0xcf,
+	0x24, 0x9f, 0x67, 0x1a, 0x47, 0x71, 0x33, 0x69, 0xb3, 0xb3, 0x7a, 0xfb, 0xe4, 0x96, 0x88, 0xc2,
+	0xd0, 0x86, 0xc6, 0x2d, 0x67, 0xdc, 0xa5, 0x7f, 0x5b, 0xa4, 0xf6, 0xde, 0x61, 0x68, 0x2d, 0x99,
+	0xd3, 0x0d, 0xef, 0x36, 0x3b, 0x02, 0xb6, 0x3b, 0x02, 0x7e, 0x76, 0x04, 0xac, 0xf7, 0x24, 0xd8,
+	0xee, 0x49, 0xf0, 0xb9, 0x27, 0xc1, 0xcb, 0xb9, 0xef, 0xec, 0xbd, 0xee, 0xce, 0xac, 0x96, 0x5c,
+	0x4f, 0x23, 0x77, 0xc1, 0xc3, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x66, 0xae, 0xa4, 0xeb, 0xbc,
+	0x01, 0x00, 0x00,
+}
+
+func (m *ItemRevision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ItemRevision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ItemRevision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Item.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.ChangedFields) > 0 {
+		for iNdEx := len(m.ChangedFields) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChangedFields[iNdEx])
+			copy(dAtA[i:], m.ChangedFields[iNdEx])
+			i = encodeVarintHistory(dAtA, i, uint64(len(m.ChangedFields[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Editor) > 0 {
+		i -= len(m.Editor)
+		copy(dAtA[i:], m.Editor)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Editor)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintHistory(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Version != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ItemId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.ItemId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHistory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHistory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ItemRevision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ItemId != 0 {
+		n += 1 + sovHistory(uint64(m.ItemId))
+	}
+	if m.Version != 0 {
+		n += 1 + sovHistory(uint64(m.Version))
+	}
+	if m.Height != 0 {
+		n += 1 + sovHistory(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovHistory(uint64(l))
+	l = len(m.Editor)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if len(m.ChangedFields) > 0 {
+		for _, s := range m.ChangedFields {
+			l = len(s)
+			n += 1 + l + sovHistory(uint64(l))
+		}
+	}
+	l = m.Item.Size()
+	n += 1 + l + sovHistory(uint64(l))
+	return n
+}
+
+func sovHistory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHistory(x uint64) (n int) {
+	return sovHistory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ItemRevision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ItemRevision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ItemRevision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemId", wireType)
+			}
+			m.ItemId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ItemId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Editor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Editor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangedFields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangedFields = append(m.ChangedFields, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipHistory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthHistory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupHistory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthHistory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthHistory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowHistory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupHistory = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,223 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+)
+
+const (
+	// PortID is the port the ICS-721 application is routed from.
+	PortID = "nft-transfer"
+	// Version is the ICS-721 version of the channels of the application.
+	Version = "ics721-1"
+	// VoucherPrefix prefixes the namespaces of the collections holding the
+	// items received over ICS-721. It is followed by the hash of the trace of
+	// their class.
+	VoucherPrefix = "ibc/"
+)
+
+// NonFungibleTokenPacketData is the ICS-721 packet data. It is encoded as
+// sorted JSON, as defined by the spec, so that it can be exchanged with any
+// ICS-721 implementation.
+type NonFungibleTokenPacketData struct {
+	ClassID   string   `json:"classId"`
+	ClassURI  string   `json:"classUri,omitempty"`
+	ClassData string   `json:"classData,omitempty"`
+	TokenIDs  []string `json:"tokenIds"`
+	TokenURIs []string `json:"tokenUris,omitempty"`
+	TokenData []string `json:"tokenData,omitempty"`
+	Sender    string   `json:"sender"`
+	Receiver  string   `json:"receiver"`
+	Memo      string   `json:"memo,omitempty"`
+}
+
+func NewNonFungibleTokenPacketData(classID string, tokenIDs, tokenURIs []string, sender, receiver, memo string) NonFungibleTokenPacketData {
+	return NonFungibleTokenPacketData{
+		ClassID:   classID,
+		TokenIDs:  tokenIDs,
+		TokenURIs: tokenURIs,
+		Sender:    sender,
+		Receiver:  receiver,
+		Memo:      memo,
+	}
+}
+
+// ValidateBasic performs basic validation of the packet data.
+func (d NonFungibleTokenPacketData) ValidateBasic() error {
+	if strings.TrimSpace(d.ClassID) == "" {
+		return errorsmod.Wrap(ErrInvalidPacket, "class id cannot be blank")
+	}
+	if len(d.TokenIDs) == 0 {
+		return errorsmod.Wrap(ErrInvalidPacket, "token ids cannot be empty")
+	}
+	seen := make(map[string]bool, len(d.TokenIDs))
+	for _, id := range d.TokenIDs {
+		if strings.TrimSpace(id) == "" {
+			return errorsmod.Wrap(ErrInvalidPacket, "token id cannot be blank")
+		}
+		if seen[id] {
+			return errorsmod.Wrapf(ErrInvalidPacket, "duplicated token id %s", id)
+		}
+		seen[id] = true
+	}
+	if len(d.TokenURIs) != 0 && len(d.TokenURIs) != len(d.TokenIDs) {
+		return errorsmod.Wrap(ErrInvalidPacket, "token uris must match the token ids")
+	}
+	if len(d.TokenData) != 0 && len(d.TokenData) != len(d.TokenIDs) {
+		return errorsmod.Wrap(ErrInvalidPacket, "token data must match the token ids")
+	}
+	if strings.TrimSpace(d.Sender) == "" {
+		return errorsmod.Wrap(ErrInvalidPacket, "sender cannot be blank")
+	}
+	if strings.TrimSpace(d.Receiver) == "" {
+		return errorsmod.Wrap(ErrInvalidPacket, "receiver cannot be blank")
+	}
+	return nil
+}
+
+// TokenURI returns the uri of the i-th token of the packet, if any.
+func (d NonFungibleTokenPacketData) TokenURI(i int) string {
+	if i < len(d.TokenURIs) {
+		return d.TokenURIs[i]
+	}
+	return ""
+}
+
+// GetBytes returns the sorted JSON encoding of the packet data.
+func (d NonFungibleTokenPacketData) GetBytes() []byte {
+	bz, err := json.Marshal(d)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// UnmarshalPacketData decodes and validates ICS-721 packet data.
+func UnmarshalPacketData(bz []byte) (NonFungibleTokenPacketData, error) {
+	var data NonFungibleTokenPacketData
+	if err := json.Unmarshal(bz, &data); err != nil {
+		return NonFungibleTokenPacketData{}, errorsmod.Wrapf(ErrInvalidPacket, "cannot unmarshal ICS-721 packet data: %s", err)
+	}
+	if err := data.ValidateBasic(); err != nil {
+		return NonFungibleTokenPacketData{}, err
+	}
+	return data, nil
+}
+
+// ParseClassTrace splits the id a class has on this chain, as carried by
+// ICS-721 packets, into the port/channel pairs it went through and its base
+// class id.
+func ParseClassTrace(classID string) ClassTrace {
+	parts := strings.Split(classID, "/")
+	var path []string
+	// A pair is part of the path only if it is followed by a base class id
+	for i := 0; i+2 < len(parts); i += 2 {
+		if !channeltypes.IsValidChannelID(parts[i+1]) {
+			break
+		}
+		path = append(path, parts[i], parts[i+1])
+	}
+	return ClassTrace{
+		Path:        strings.Join(path, "/"),
+		BaseClassId: strings.Join(parts[len(path):], "/"),
+	}
+}
+
+// GetFullClassPath returns the id of the class on this chain, its path
+// followed by its base class id.
+func (ct ClassTrace) GetFullClassPath() string {
+	if ct.Path == "" {
+		return ct.BaseClassId
+	}
+	return ct.Path + "/" + ct.BaseClassId
+}
+
+// Hash returns the SHA-256 hash of the full class path.
+func (ct ClassTrace) Hash() []byte {
+	hash := sha256.Sum256([]byte(ct.GetFullClassPath()))
+	return hash[:]
+}
+
+// HashKey returns the upper case hex encoding of the hash of the trace, which
+// class traces are stored under.
+func (ct ClassTrace) HashKey() string {
+	return strings.ToUpper(hex.EncodeToString(ct.Hash()))
+}
+
+// IBCClassID returns the namespace of the voucher collection of the class.
+func (ct ClassTrace) IBCClassID() string {
+	return VoucherPrefix + ct.HashKey()
+}
+
+// Validate performs basic validation of the class trace.
+func (ct ClassTrace) Validate() error {
+	if strings.TrimSpace(ct.BaseClassId) == "" {
+		return errorsmod.Wrap(ErrInvalidClassTrace, "base class id cannot be blank")
+	}
+	if ct.Path == "" {
+		return nil
+	}
+	parts := strings.Split(ct.Path, "/")
+	if len(parts)%2 != 0 {
+		return errorsmod.Wrapf(ErrInvalidClassTrace, "path %s is not made of port/channel pairs", ct.Path)
+	}
+	for i := 0; i < len(parts); i += 2 {
+		if err := host.PortIdentifierValidator(parts[i]); err != nil {
+			return errorsmod.Wrapf(ErrInvalidClassTrace, "invalid port in path %s: %s", ct.Path, err)
+		}
+		if err := host.ChannelIdentifierValidator(parts[i+1]); err != nil {
+			return errorsmod.Wrapf(ErrInvalidClassTrace, "invalid channel in path %s: %s", ct.Path, err)
+		}
+	}
+	return nil
+}
+
+// ParseClassHash returns the hash key of a class trace given its hex encoded
+// hash, with or without the voucher prefix.
+func ParseClassHash(hash string) (string, error) {
+	bz, err := hex.DecodeString(strings.TrimPrefix(hash, VoucherPrefix))
+	if err != nil {
+		return "", errorsmod.Wrapf(ErrInvalidClassTrace, "invalid class hash %s: %s", hash, err)
+	}
+	if len(bz) != sha256.Size {
+		return "", errorsmod.Wrapf(ErrInvalidClassTrace, "invalid class hash %s: expected %d bytes", hash, sha256.Size)
+	}
+	return strings.ToUpper(hex.EncodeToString(bz)), nil
+}
+
+// IsVoucherNamespace returns whether a namespace is the one of a voucher
+// collection.
+func IsVoucherNamespace(namespace string) bool {
+	return strings.HasPrefix(namespace, VoucherPrefix)
+}
+
+// ClassPrefix returns the prefix a channel end adds to the ids of the classes
+// going through it.
+func ClassPrefix(portID, channelID string) string {
+	return portID + "/" + channelID + "/"
+}
+
+// ReceiverChainIsSource returns whether the class of a packet sent from the
+// given channel end originates from the chain receiving it, that is whether
+// the items go back where they came from.
+func ReceiverChainIsSource(sourcePort, sourceChannel, classID string) bool {
+	return strings.HasPrefix(classID, ClassPrefix(sourcePort, sourceChannel))
+}
+
+// GetEscrowAddress returns the address the items sent over a channel are
+// escrowed to, until they come back.
+func GetEscrowAddress(portID, channelID string) sdk.AccAddress {
+	preImage := []byte(Version)
+	preImage = append(preImage, 0)
+	preImage = append(preImage, fmt.Sprintf("%s/%s", portID, channelID)...)
+	hash := sha256.Sum256(preImage)
+	return hash[:20]
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"regexp"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	// MaxItemAliasLength is the maximum length of an item alias.
+	MaxItemAliasLength = 64
+	// MaxNamespaceLength is the maximum length of a namespace.
+	MaxNamespaceLength = 64
+)
+
+var itemAliasRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]*$`)
+
+// ValidateItemAlias checks that an alias is a non-empty handle made of
+// letters, digits, dots, dashes and underscores.
+func ValidateItemAlias(alias string) error {
+	if len(alias) > MaxItemAliasLength {
+		return errorsmod.Wrapf(ErrInvalidAlias, "alias is longer than %d characters", MaxItemAliasLength)
+	}
+	if !itemAliasRegex.MatchString(alias) {
+		return errorsmod.Wrapf(ErrInvalidAlias, "alias %q must start with a letter or digit and contain only letters, digits, '.', '_' or '-'", alias)
+	}
+	return nil
+}
+
+// ValidateNamespace checks that a namespace follows the same rules as an
+// item alias, or is the namespace of a voucher collection.
+func ValidateNamespace(namespace string) error {
+	if IsVoucherNamespace(namespace) {
+		hash, err := ParseClassHash(namespace)
+		if err != nil || namespace != VoucherPrefix+hash {
+			return errorsmod.Wrapf(ErrInvalidNamespace, "invalid voucher namespace %q", namespace)
+		}
+		return nil
+	}
+	if len(namespace) > MaxNamespaceLength {
+		return errorsmod.Wrapf(ErrInvalidNamespace, "namespace is longer than %d characters", MaxNamespaceLength)
+	}
+	if !itemAliasRegex.MatchString(namespace) {
+		return errorsmod.Wrapf(ErrInvalidNamespace, "namespace %q must start with a letter or digit and contain only letters, digits, '.', '_' or '-'", namespace)
+	}
+	return nil
+}
+
+// ActiveUser returns the user of the item if their rental has not ended at
+// the given time, or an empty string.
+func (i Item) ActiveUser(now time.Time) string {
+	if i.User == "" || i.UserExpiresAt == nil || !now.Before(*i.UserExpiresAt) {
+		return ""
+	}
+	return i.User
+}
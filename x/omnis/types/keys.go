@@ -13,26 +13,99 @@ const (
 	// It should be synced with the gov module's name if it is ever changed.
 	// See: https://github.com/cosmos/cosmos-sdk/blob/v0.52.0-beta.2/x/gov/types/keys.go#L9
 	GovModuleName = "gov"
-
-    // Event types
-    EventTypeCreateItem = "create_item" // New event type for item creation
-    EventTypeUpdateItem = "update_item" // New event type for item update
-    EventTypeDeleteItem = "delete_item" // New event type for item deletion
-
-    // Attribute keys for events
-    AttributeKeyItemID      = "item_id"
-    AttributeKeyItemName    = "item_name"
-    AttributeKeyNewItemName = "new_item_name" // If you allow name updates
-    AttributeKeyCreator     = "creator"
-    // Add other attribute keys as needed for your module's events
 )
 
 // ParamsKey is the prefix to retrieve all Params
 var ParamsKey = collections.NewPrefix("p_omnis")
 
 // ItemKeyPrefix is the prefix to retrieve all Items
-var ItemKeyPrefix = collections.NewPrefix("i_omnis_item") // NEW: Unique prefix for your items
-// You can use bytes (e.g., collections.NewPrefix(0x01)) or strings.
-// String prefixes are often more human-readable in debug tools.
-// Ensure "i_omnis_item" is truly unique within your module's store.
-// If you have multiple distinct data types, give each its own prefix.
\ No newline at end of file
+var ItemKeyPrefix = collections.NewPrefix("i_omnis_item")
+
+// ItemOwnerIndexPrefix is the prefix of the owner index of Items
+var ItemOwnerIndexPrefix = collections.NewPrefix("o_omnis_item_owner")
+
+// ItemCountKey is the key of the item id sequence
+var ItemCountKey = collections.NewPrefix("c_omnis_item_count")
+
+// ItemAliasKeyPrefix is the prefix of the creator-scoped item aliases
+var ItemAliasKeyPrefix = collections.NewPrefix("a_omnis_item_alias")
+
+// AttributeSchemaKeyPrefix is the prefix of the namespace attribute schemas
+var AttributeSchemaKeyPrefix = collections.NewPrefix("s_omnis_attribute_schema")
+
+// ItemAttributeIndexPrefix is the prefix of the attribute index of Items
+var ItemAttributeIndexPrefix = collections.NewPrefix("x_omnis_item_attribute")
+
+// CollectionKeyPrefix is the prefix of the item collections
+var CollectionKeyPrefix = collections.NewPrefix("n_omnis_collection")
+
+// CollectionItemCountKeyPrefix is the prefix of the number of items per collection
+var CollectionItemCountKeyPrefix = collections.NewPrefix("m_omnis_collection_items")
+
+// CollectionCreatorCountKeyPrefix is the prefix of the number of items per
+// collection and creator
+var CollectionCreatorCountKeyPrefix = collections.NewPrefix("k_omnis_collection_creator_items")
+
+// ItemRevisionKeyPrefix is the prefix of the version log of Items
+var ItemRevisionKeyPrefix = collections.NewPrefix("r_omnis_item_revision")
+
+// ItemContentHashIndexPrefix is the prefix of the content hash index of Items
+var ItemContentHashIndexPrefix = collections.NewPrefix("h_omnis_item_content_hash")
+
+// NotarizationKeyPrefix is the prefix of the first registration of content hashes
+var NotarizationKeyPrefix = collections.NewPrefix("t_omnis_notarization")
+
+// ItemExpiryQueuePrefix is the prefix of the queue of Items by expiry time
+var ItemExpiryQueuePrefix = collections.NewPrefix("e_omnis_item_expiry")
+
+// ItemApprovalKeyPrefix is the prefix of the per-item operator approvals
+var ItemApprovalKeyPrefix = collections.NewPrefix("d_omnis_item_approval")
+
+// OperatorApprovalKeyPrefix is the prefix of the per-owner operator approvals
+var OperatorApprovalKeyPrefix = collections.NewPrefix("g_omnis_operator_approval")
+
+// ListingKeyPrefix is the prefix of the marketplace listings
+var ListingKeyPrefix = collections.NewPrefix("l_omnis_listing")
+
+// ListingCollectionIndexPrefix is the prefix of the collection index of Listings
+var ListingCollectionIndexPrefix = collections.NewPrefix("j_omnis_listing_collection")
+
+// ListingSellerIndexPrefix is the prefix of the seller index of Listings
+var ListingSellerIndexPrefix = collections.NewPrefix("q_omnis_listing_seller")
+
+// ItemUserExpiryQueuePrefix is the prefix of the queue of rented Items by the
+// end of their rental
+var ItemUserExpiryQueuePrefix = collections.NewPrefix("u_omnis_item_user_expiry")
+
+// ClassTraceKeyPrefix is the prefix of the traces of the ICS-721 classes
+// received, keyed by hash
+var ClassTraceKeyPrefix = collections.NewPrefix("b_omnis_class_trace")
+
+// VoucherTokenKeyPrefix is the prefix of the token ids of the items received
+// over ICS-721
+var VoucherTokenKeyPrefix = collections.NewPrefix("v_omnis_voucher_token")
+
+// VoucherTokenIndexPrefix is the prefix of the collection and token id index
+// of VoucherTokens
+var VoucherTokenIndexPrefix = collections.NewPrefix("w_omnis_voucher_token_index")
+
+// FractionKeyPrefix is the prefix of the fractionalized items
+var FractionKeyPrefix = collections.NewPrefix("f_omnis_fraction")
+
+// AttestationKeyPrefix is the prefix of the attestations, keyed by item,
+// attester and claim type
+var AttestationKeyPrefix = collections.NewPrefix("y_omnis_attestation")
+
+// AttestationAttesterIndexPrefix is the prefix of the attester index of
+// Attestations
+var AttestationAttesterIndexPrefix = collections.NewPrefix("z_omnis_attestation_attester")
+
+// LifecycleKeyPrefix is the prefix of the collection lifecycles
+var LifecycleKeyPrefix = collections.NewPrefix("A_omnis_lifecycle")
+
+// ItemStateIndexPrefix is the prefix of the lifecycle state index of Items
+var ItemStateIndexPrefix = collections.NewPrefix("B_omnis_item_state")
+
+// ProvenanceKeyPrefix is the prefix of the custody records of items, keyed by
+// item id and sequence
+var ProvenanceKeyPrefix = collections.NewPrefix("C_omnis_provenance")
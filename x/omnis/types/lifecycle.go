@@ -0,0 +1,112 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// MaxLifecycleStates is the maximum number of states of a lifecycle.
+	MaxLifecycleStates = 64
+	// MaxLifecycleTransitions is the maximum number of transitions of a
+	// lifecycle.
+	MaxLifecycleTransitions = 256
+	// MaxStateLength is the maximum length of a lifecycle state.
+	MaxStateLength = 64
+)
+
+// ValidateState checks that a lifecycle state follows the format of attribute
+// keys.
+func ValidateState(state string) error {
+	if len(state) > MaxStateLength {
+		return errorsmod.Wrapf(ErrInvalidLifecycle, "state is longer than %d characters", MaxStateLength)
+	}
+	if !attributeKeyRegex.MatchString(state) {
+		return errorsmod.Wrapf(ErrInvalidLifecycle, "state %q must start with a letter and contain only letters, digits, '.', '_' or '-'", state)
+	}
+	return nil
+}
+
+// Validate performs basic validation of the lifecycle.
+func (l ItemLifecycle) Validate() error {
+	if err := ValidateNamespace(l.Namespace); err != nil {
+		return err
+	}
+	if len(l.States) == 0 || len(l.States) > MaxLifecycleStates {
+		return errorsmod.Wrapf(ErrInvalidLifecycle, "a lifecycle must have between 1 and %d states", MaxLifecycleStates)
+	}
+	if len(l.Transitions) > MaxLifecycleTransitions {
+		return errorsmod.Wrapf(ErrInvalidLifecycle, "a lifecycle cannot have more than %d transitions", MaxLifecycleTransitions)
+	}
+
+	states := make(map[string]bool, len(l.States))
+	for _, state := range l.States {
+		if err := ValidateState(state); err != nil {
+			return err
+		}
+		if states[state] {
+			return errorsmod.Wrapf(ErrInvalidLifecycle, "duplicated state %s", state)
+		}
+		states[state] = true
+	}
+	if !states[l.InitialState] {
+		return errorsmod.Wrapf(ErrInvalidLifecycle, "unknown initial state %q", l.InitialState)
+	}
+
+	transitions := make(map[[2]string]bool, len(l.Transitions))
+	for _, transition := range l.Transitions {
+		if !states[transition.From] || !states[transition.To] {
+			return errorsmod.Wrapf(ErrInvalidLifecycle, "transition %s -> %s between unknown states", transition.From, transition.To)
+		}
+		if transition.From == transition.To {
+			return errorsmod.Wrapf(ErrInvalidLifecycle, "transition %s -> %s does not change the state", transition.From, transition.To)
+		}
+		key := [2]string{transition.From, transition.To}
+		if transitions[key] {
+			return errorsmod.Wrapf(ErrInvalidLifecycle, "duplicated transition %s -> %s", transition.From, transition.To)
+		}
+		transitions[key] = true
+
+		if len(transition.Roles) == 0 && len(transition.Accounts) == 0 {
+			return errorsmod.Wrapf(ErrInvalidLifecycle, "nobody may trigger transition %s -> %s", transition.From, transition.To)
+		}
+		for _, role := range transition.Roles {
+			if _, ok := TransitionRole_name[int32(role)]; !ok || role == TRANSITION_ROLE_UNSPECIFIED {
+				return errorsmod.Wrapf(ErrInvalidLifecycle, "invalid role %s of transition %s -> %s", role, transition.From, transition.To)
+			}
+		}
+		for _, addr := range transition.Accounts {
+			if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid account %s of transition %s -> %s: %s", addr, transition.From, transition.To, err)
+			}
+		}
+	}
+	return nil
+}
+
+// HasState returns whether state is a state of the lifecycle.
+func (l ItemLifecycle) HasState(state string) bool {
+	for _, s := range l.States {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
+
+// Transition returns the transition from a state to another.
+func (l ItemLifecycle) Transition(from, to string) (LifecycleTransition, bool) {
+	for _, transition := range l.Transitions {
+		if transition.From == from && transition.To == to {
+			return transition, true
+		}
+	}
+	return LifecycleTransition{}, false
+}
+
+// StateIndexKey returns the key under which items of the collection namespace
+// in state are indexed.
+func StateIndexKey(namespace, state string) string {
+	return namespace + "/" + state
+}
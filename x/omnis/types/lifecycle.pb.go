@@ -0,0 +1,931 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: omnis/omnis/v1/lifecycle.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TransitionRole is a role allowed to trigger a lifecycle transition.
+type TransitionRole int32
+
+const (
+	TRANSITION_ROLE_UNSPECIFIED TransitionRole = 0
+	// TRANSITION_ROLE_OWNER is the owner of the item.
+	TRANSITION_ROLE_OWNER TransitionRole = 1
+	// TRANSITION_ROLE_OPERATOR is an operator approved by the owner of the
+	// item, or its current user.
+	TRANSITION_ROLE_OPERATOR TransitionRole = 2
+	// TRANSITION_ROLE_ADMIN is the admin of the collection of the item.
+	TRANSITION_ROLE_ADMIN TransitionRole = 3
+	// TRANSITION_ROLE_ATTESTER is an attester of the collection of the item.
+	TRANSITION_ROLE_ATTESTER TransitionRole = 4
+)
+
+var TransitionRole_name = map[int32]string{
+	0: "TRANSITION_ROLE_UNSPECIFIED",
+	1: "TRANSITION_ROLE_OWNER",
+	2: "TRANSITION_ROLE_OPERATOR",
+	3: "TRANSITION_ROLE_ADMIN",
+	4: "TRANSITION_ROLE_ATTESTER",
+}
+
+var TransitionRole_value = map[string]int32{
+	"TRANSITION_ROLE_UNSPECIFIED": 0,
+	"TRANSITION_ROLE_OWNER":       1,
+	"TRANSITION_ROLE_OPERATOR":    2,
+	"TRANSITION_ROLE_ADMIN":       3,
+	"TRANSITION_ROLE_ATTESTER":    4,
+}
+
+func (x TransitionRole) String() string {
+	return proto.EnumName(TransitionRole_name, int32(x))
+}
+
+func (TransitionRole) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1409587355bfe7e2, []int{0}
+}
+
+// LifecycleTransition is an allowed move of an item from one state to
+// another.
+type LifecycleTransition struct {
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To   string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// roles may trigger the transition.
+	Roles []TransitionRole `protobuf:"varint,3,rep,packed,name=roles,proto3,enum=omnis.omnis.v1.TransitionRole" json:"roles,omitempty"`
+	// accounts may trigger the transition in addition to the roles, e.g. a
+	// carrier.
+	Accounts []string `protobuf:"bytes,4,rep,name=accounts,proto3" json:"accounts,omitempty"`
+}
+
+func (m *LifecycleTransition) Reset()         { *m = LifecycleTransition{} }
+func (m *LifecycleTransition) String() string { return proto.CompactTextString(m) }
+func (*LifecycleTransition) ProtoMessage()    {}
+func (*LifecycleTransition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1409587355bfe7e2, []int{0}
+}
+func (m *LifecycleTransition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LifecycleTransition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LifecycleTransition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LifecycleTransition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LifecycleTransition.Merge(m, src)
+}
+func (m *LifecycleTransition) XXX_Size() int {
+	return m.Size()
+}
+func (m *LifecycleTransition) XXX_DiscardUnknown() {
+	xxx_messageInfo_LifecycleTransition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LifecycleTransition proto.InternalMessageInfo
+
+func (m *LifecycleTransition) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *LifecycleTransition) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *LifecycleTransition) GetRoles() []TransitionRole {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *LifecycleTransition) GetAccounts() []string {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+// ItemLifecycle is the state machine the items of a collection move through.
+// Items enter the initial state when they are created. The items created
+// before the lifecycle was set have no state until their first transition,
+// which starts from the initial state.
+type ItemLifecycle struct {
+	// namespace is the namespace of the collection the lifecycle belongs to.
+	Namespace    string                `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	States       []string              `protobuf:"bytes,2,rep,name=states,proto3" json:"states,omitempty"`
+	InitialState string                `protobuf:"bytes,3,opt,name=initial_state,json=initialState,proto3" json:"initial_state,omitempty"`
+	Transitions  []LifecycleTransition `protobuf:"bytes,4,rep,name=transitions,proto3" json:"transitions"`
+}
+
+func (m *ItemLifecycle) Reset()         { *m = ItemLifecycle{} }
+func (m *ItemLifecycle) String() string { return proto.CompactTextString(m) }
+func (*ItemLifecycle) ProtoMessage()    {}
+func (*ItemLifecycle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1409587355bfe7e2, []int{1}
+}
+func (m *ItemLifecycle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ItemLifecycle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ItemLifecycle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ItemLifecycle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ItemLifecycle.Merge(m, src)
+}
+func (m *ItemLifecycle) XXX_Size() int {
+	return m.Size()
+}
+func (m *ItemLifecycle) XXX_DiscardUnknown() {
+	xxx_messageInfo_ItemLifecycle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ItemLifecycle proto.InternalMessageInfo
+
+func (m *ItemLifecycle) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ItemLifecycle) GetStates() []string {
+	if m != nil {
+		return m.States
+	}
+	return nil
+}
+
+func (m *ItemLifecycle) GetInitialState() string {
+	if m != nil {
+		return m.InitialState
+	}
+	return ""
+}
+
+func (m *ItemLifecycle) GetTransitions() []LifecycleTransition {
+	if m != nil {
+		return m.Transitions
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("omnis.omnis.v1.TransitionRole", TransitionRole_name, TransitionRole_value)
+	proto.RegisterType((*LifecycleTransition)(nil), "omnis.omnis.v1.LifecycleTransition")
+	proto.RegisterType((*ItemLifecycle)(nil), "omnis.omnis.v1.ItemLifecycle")
+}
+
+func init() { proto.RegisterFile("omnis/omnis/v1/lifecycle.proto", fileDescriptor_1409587355bfe7e2) }
+
+var fileDescriptor_1409587355bfe7e2 = []byte{
+	// 440 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0x41, 0x6b, 0xd4, 0x40,
+	0x14, 0xce, 0x24, 0xb1, 0xb8, 0x53, 0xbb, 0x84, 0x69, 0x95, 0xe9, 0x5a, 0xa6, 0x4b, 0x7b, 0x59,
+	0x84, 0x66, 0x69, 0xed, 0x1f, 0xc8, 0xda, 0x08, 0xc1, 0x9a, 0x94, 0x49, 0x44, 0xf0, 0x12, 0x62,
+	0x3a, 0x5d, 0x06, 0x92, 0xcc, 0x92, 0x19, 0x8b, 0xfd, 0x07, 0x1e, 0xfd, 0x0f, 0xe2, 0xc1, 0xbb,
+	0xe0, 0x5f, 0xe8, 0xb1, 0x78, 0xf2, 0x24, 0xb2, 0xfb, 0x47, 0x64, 0x33, 0x71, 0x6b, 0xb7, 0x7b,
+	0x79, 0xbc, 0xf7, 0xbd, 0xef, 0x7b, 0xf3, 0xcd, 0xe3, 0x41, 0x22, 0xca, 0x8a, 0xcb, 0xa1, 0x8e,
+	0x97, 0x87, 0xc3, 0x82, 0x5f, 0xb0, 0xfc, 0x2a, 0x2f, 0x98, 0x3b, 0xa9, 0x85, 0x12, 0xa8, 0xdb,
+	0x74, 0x5c, 0x1d, 0x2f, 0x0f, 0x7b, 0xdb, 0xb9, 0x90, 0xa5, 0x90, 0x69, 0xd3, 0x1d, 0xea, 0x42,
+	0x53, 0x7b, 0x5b, 0x63, 0x31, 0x16, 0x1a, 0x9f, 0x67, 0x1a, 0xdd, 0xfb, 0x0a, 0xe0, 0xe6, 0xe9,
+	0xbf, 0xa1, 0x49, 0x9d, 0x55, 0x92, 0x2b, 0x2e, 0x2a, 0x84, 0xa0, 0x7d, 0x51, 0x8b, 0x12, 0x83,
+	0x3e, 0x18, 0x74, 0x68, 0x93, 0xa3, 0x2e, 0x34, 0x95, 0xc0, 0x66, 0x83, 0x98, 0x4a, 0xa0, 0x63,
+	0xf8, 0xa0, 0x16, 0x05, 0x93, 0xd8, 0xea, 0x5b, 0x83, 0xee, 0x11, 0x71, 0xef, 0x9a, 0x71, 0x6f,
+	0xc7, 0x51, 0x51, 0x30, 0xaa, 0xc9, 0xe8, 0x18, 0x3e, 0xcc, 0xf2, 0x5c, 0x7c, 0xa8, 0x94, 0xc4,
+	0x76, 0xdf, 0x1a, 0x74, 0x46, 0xf8, 0xe7, 0xf7, 0x83, 0xad, 0xd6, 0xab, 0x77, 0x7e, 0x5e, 0x33,
+	0x29, 0x63, 0x55, 0xf3, 0x6a, 0x4c, 0x17, 0xcc, 0xbd, 0x1f, 0x00, 0x6e, 0x04, 0x8a, 0x95, 0x0b,
+	0xaf, 0x68, 0x07, 0x76, 0xaa, 0xac, 0x64, 0x72, 0x92, 0xe5, 0xac, 0xb5, 0x79, 0x0b, 0xa0, 0x27,
+	0x70, 0x4d, 0xaa, 0x4c, 0x31, 0x89, 0xcd, 0xf9, 0x1b, 0xb4, 0xad, 0xd0, 0x3e, 0xdc, 0xe0, 0x15,
+	0x57, 0x3c, 0x2b, 0xd2, 0x06, 0xc1, 0x56, 0xa3, 0x7c, 0xd4, 0x82, 0xf1, 0x1c, 0x43, 0xaf, 0xe0,
+	0xba, 0x5a, 0x78, 0xd7, 0x2e, 0xd7, 0x8f, 0xf6, 0x97, 0xbf, 0xb7, 0x62, 0x6d, 0x23, 0xfb, 0xfa,
+	0xf7, 0xae, 0x41, 0xff, 0x57, 0x3f, 0xfb, 0x06, 0x60, 0xf7, 0xee, 0x26, 0xd0, 0x2e, 0x7c, 0x9a,
+	0x50, 0x2f, 0x8c, 0x83, 0x24, 0x88, 0xc2, 0x94, 0x46, 0xa7, 0x7e, 0xfa, 0x26, 0x8c, 0xcf, 0xfc,
+	0x17, 0xc1, 0xcb, 0xc0, 0x3f, 0x71, 0x0c, 0xb4, 0x0d, 0x1f, 0x2f, 0x13, 0xa2, 0xb7, 0xa1, 0x4f,
+	0x1d, 0x80, 0x76, 0x20, 0xbe, 0xd7, 0x3a, 0xf3, 0xa9, 0x97, 0x44, 0xd4, 0x31, 0x57, 0x09, 0xbd,
+	0x93, 0xd7, 0x41, 0xe8, 0x58, 0xab, 0x84, 0x5e, 0x92, 0xf8, 0x71, 0xe2, 0x53, 0xc7, 0xee, 0xd9,
+	0x9f, 0xbe, 0x10, 0x63, 0x74, 0x70, 0x3d, 0x25, 0xe0, 0x66, 0x4a, 0xc0, 0x9f, 0x29, 0x01, 0x9f,
+	0x67, 0xc4, 0xb8, 0x99, 0x11, 0xe3, 0xd7, 0x8c, 0x18, 0xef, 0x36, 0xf5, 0x09, 0x7e, 0x6c, 0x4f,
+	0x51, 0x5d, 0x4d, 0x98, 0x7c, 0xbf, 0xd6, 0xdc, 0xd0, 0xf3, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff,
+	0x17, 0x5c, 0x8e, 0x00, 0xa6, 0x02, 0x00, 0x00,
+}
+
+func (m *LifecycleTransition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LifecycleTransition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LifecycleTransition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Accounts[iNdEx])
+			copy(dAtA[i:], m.Accounts[iNdEx])
+			i = encodeVarintLifecycle(dAtA, i, uint64(len(m.Accounts[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Roles) > 0 {
+		dAtA2 := make([]byte, len(m.Roles)*10)
+		var j1 int
+		for _, num := range m.Roles {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintLifecycle(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintLifecycle(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintLifecycle(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ItemLifecycle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ItemLifecycle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ItemLifecycle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Transitions) > 0 {
+		for iNdEx := len(m.Transitions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLifecycle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.InitialState) > 0 {
+		i -= len(m.InitialState)
+		copy(dAtA[i:], m.InitialState)
+		i = encodeVarintLifecycle(dAtA, i, uint64(len(m.InitialState)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.States) > 0 {
+		for iNdEx := len(m.States) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.States[iNdEx])
+			copy(dAtA[i:], m.States[iNdEx])
+			i = encodeVarintLifecycle(dAtA, i, uint64(len(m.States[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintLifecycle(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLifecycle(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLifecycle(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LifecycleTransition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovLifecycle(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovLifecycle(uint64(l))
+	}
+	if len(m.Roles) > 0 {
+		l = 0
+		for _, e := range m.Roles {
+			l += sovLifecycle(uint64(e))
+		}
+		n += 1 + sovLifecycle(uint64(l)) + l
+	}
+	if len(m.Accounts) > 0 {
+		for _, s := range m.Accounts {
+			l = len(s)
+			n += 1 + l + sovLifecycle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ItemLifecycle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovLifecycle(uint64(l))
+	}
+	if len(m.States) > 0 {
+		for _, s := range m.States {
+			l = len(s)
+			n += 1 + l + sovLifecycle(uint64(l))
+		}
+	}
+	l = len(m.InitialState)
+	if l > 0 {
+		n += 1 + l + sovLifecycle(uint64(l))
+	}
+	if len(m.Transitions) > 0 {
+		for _, e := range m.Transitions {
+			l = e.Size()
+			n += 1 + l + sovLifecycle(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovLifecycle(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLifecycle(x uint64) (n int) {
+	return sovLifecycle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LifecycleTransition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLifecycle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LifecycleTransition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LifecycleTransition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLifecycle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLifecycle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLifecycle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLifecycle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLifecycle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLifecycle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v TransitionRole
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLifecycle
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= TransitionRole(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Roles = append(m.Roles, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLifecycle
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthLifecycle
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthLifecycle
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Roles) == 0 {
+					m.Roles = make([]TransitionRole, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v TransitionRole
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLifecycle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= TransitionRole(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Roles = append(m.Roles, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLifecycle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLifecycle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLifecycle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLifecycle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLifecycle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ItemLifecycle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLifecycle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ItemLifecycle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ItemLifecycle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLifecycle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLifecycle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLifecycle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field States", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLifecycle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLifecycle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLifecycle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.States = append(m.States, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLifecycle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLifecycle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLifecycle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitialState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transitions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLifecycle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLifecycle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLifecycle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transitions = append(m.Transitions, LifecycleTransition{})
+			if err := m.Transitions[len(m.Transitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLifecycle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLifecycle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLifecycle(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLifecycle
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLifecycle
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLifecycle
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLifecycle
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLifecycle
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLifecycle
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLifecycle        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLifecycle          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLifecycle = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,31 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateListingPrice checks that a price is positive and in one of the
+// marketplace denoms.
+func (p Params) ValidateListingPrice(price sdk.Coin) error {
+	if err := price.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidPrice, err.Error())
+	}
+	if !price.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidPrice, "price must be positive")
+	}
+	if !p.IsMarketplaceDenom(price.Denom) {
+		return errorsmod.Wrapf(ErrInvalidPrice, "denom %s is not accepted on the marketplace", price.Denom)
+	}
+	return nil
+}
+
+// MarketplaceFeeOf returns the part of a sale price paid to the fee
+// collector, rounded down.
+func (p Params) MarketplaceFeeOf(price sdk.Coin) (sdk.Coin, error) {
+	fee, err := p.MarketplaceFeeDec()
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return sdk.NewCoin(price.Denom, fee.MulInt(price.Amount).TruncateInt()), nil
+}
@@ -0,0 +1,473 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: omnis/omnis/v1/marketplace.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Listing offers an item for sale on the marketplace. It is removed when the
+// item is sold, transferred, deleted or expires.
+type Listing struct {
+	ItemId uint64 `protobuf:"varint,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	// seller is the owner of the item when it was listed, who receives the
+	// proceeds of the sale.
+	Seller string `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	// namespace is the namespace of the collection of the item.
+	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// price is the price of the item, in one of the marketplace denoms.
+	Price types.Coin `protobuf:"bytes,4,opt,name=price,proto3" json:"price"`
+}
+
+func (m *Listing) Reset()         { *m = Listing{} }
+func (m *Listing) String() string { return proto.CompactTextString(m) }
+func (*Listing) ProtoMessage()    {}
+func (*Listing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e52daab590e37aa0, []int{0}
+}
+func (m *Listing) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Listing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Listing.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Listing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Listing.Merge(m, src)
+}
+func (m *Listing) XXX_Size() int {
+	return m.Size()
+}
+func (m *Listing) XXX_DiscardUnknown() {
+	xxx_messageInfo_Listing.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Listing proto.InternalMessageInfo
+
+func (m *Listing) GetItemId() uint64 {
+	if m != nil {
+		return m.ItemId
+	}
+	return 0
+}
+
+func (m *Listing) GetSeller() string {
+	if m != nil {
+		return m.Seller
+	}
+	return ""
+}
+
+func (m *Listing) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *Listing) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*Listing)(nil), "omnis.omnis.v1.Listing")
+}
+
+func init() { proto.RegisterFile("omnis/omnis/v1/marketplace.proto", fileDescriptor_e52daab590e37aa0) }
+
+var fileDescriptor_e52daab590e37aa0 = []byte{
+	// 306 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x50, 0x41, 0x4b, 0xf3, 0x40,
+	0x14, 0xcc, 0x7e, 0x5f, 0x6d, 0xe9, 0x0a, 0x82, 0xb1, 0x60, 0x5a, 0x64, 0x0d, 0x9e, 0x8a, 0xd0,
+	0xac, 0xd1, 0x9b, 0x37, 0xeb, 0x49, 0xf0, 0x14, 0x6f, 0x5e, 0xca, 0x36, 0x79, 0x84, 0xc5, 0xee,
+	0x6e, 0xd8, 0x5d, 0x8a, 0xfe, 0x0b, 0x7f, 0x86, 0x27, 0xf1, 0xe0, 0x8f, 0xe8, 0xb1, 0x78, 0xf2,
+	0x24, 0x92, 0x1c, 0xfc, 0x1b, 0x92, 0xec, 0x82, 0x97, 0xe1, 0xcd, 0xcc, 0x7b, 0x0f, 0x66, 0x70,
+	0xac, 0x84, 0xe4, 0x86, 0x3a, 0x5c, 0xa7, 0x54, 0x30, 0xfd, 0x00, 0xb6, 0x5a, 0xb1, 0x1c, 0x92,
+	0x4a, 0x2b, 0xab, 0xc2, 0xbd, 0xce, 0x4b, 0x1c, 0xae, 0xd3, 0xc9, 0x3e, 0x13, 0x5c, 0x2a, 0xda,
+	0xa1, 0x5b, 0x99, 0x90, 0x5c, 0x19, 0xa1, 0x0c, 0x5d, 0x32, 0x03, 0x74, 0x9d, 0x2e, 0xc1, 0xb2,
+	0x94, 0xe6, 0x8a, 0x4b, 0xef, 0x8f, 0x9d, 0xbf, 0xe8, 0x18, 0x75, 0xc4, 0x5b, 0xa3, 0x52, 0x95,
+	0xca, 0xe9, 0xed, 0xe4, 0xd4, 0x93, 0x57, 0x84, 0x07, 0xb7, 0xdc, 0x58, 0x2e, 0xcb, 0xf0, 0x10,
+	0x0f, 0xb8, 0x05, 0xb1, 0xe0, 0x45, 0x84, 0x62, 0x34, 0xed, 0x65, 0xfd, 0x96, 0xde, 0x14, 0xe1,
+	0x19, 0xee, 0x1b, 0x58, 0xad, 0x40, 0x47, 0xff, 0x62, 0x34, 0x1d, 0xce, 0xa3, 0x8f, 0xf7, 0xd9,
+	0xc8, 0x3f, 0xbf, 0x2a, 0x0a, 0x0d, 0xc6, 0xdc, 0x59, 0xcd, 0x65, 0x99, 0xf9, 0xbd, 0xf0, 0x08,
+	0x0f, 0x25, 0x13, 0x60, 0x2a, 0x96, 0x43, 0xf4, 0xbf, 0x3d, 0xca, 0xfe, 0x84, 0xf0, 0x12, 0xef,
+	0x54, 0x9a, 0xe7, 0x10, 0xf5, 0x62, 0x34, 0xdd, 0x3d, 0x1f, 0x27, 0xfe, 0x57, 0x9b, 0x2a, 0xf1,
+	0xa9, 0x92, 0x6b, 0xc5, 0xe5, 0x7c, 0xb8, 0xf9, 0x3a, 0x0e, 0x5e, 0x7e, 0xde, 0x4e, 0x51, 0xe6,
+	0x4e, 0xe6, 0xb3, 0x4d, 0x4d, 0xd0, 0xb6, 0x26, 0xe8, 0xbb, 0x26, 0xe8, 0xb9, 0x21, 0xc1, 0xb6,
+	0x21, 0xc1, 0x67, 0x43, 0x82, 0xfb, 0x03, 0x57, 0xed, 0xa3, 0xaf, 0xd8, 0x3e, 0x55, 0x60, 0x96,
+	0xfd, 0x2e, 0xe6, 0xc5, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xeb, 0x9a, 0xf1, 0xf1, 0x7e, 0x01,
+	0x00, 0x00,
+}
+
+func (m *Listing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Listing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Listing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarketplace(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintMarketplace(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintMarketplace(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ItemId != 0 {
+		i = encodeVarintMarketplace(dAtA, i, uint64(m.ItemId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMarketplace(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMarketplace(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Listing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ItemId != 0 {
+		n += 1 + sovMarketplace(uint64(m.ItemId))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovMarketplace(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovMarketplace(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovMarketplace(uint64(l))
+	return n
+}
+
+func sovMarketplace(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMarketplace(x uint64) (n int) {
+	return sovMarketplace(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Listing) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarketplace
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Listing: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Listing: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemId", wireType)
+			}
+			m.ItemId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketplace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ItemId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketplace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarketplace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketplace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketplace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarketplace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketplace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketplace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarketplace
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketplace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarketplace(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarketplace
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMarketplace(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMarketplace
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMarketplace
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMarketplace
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMarketplace
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMarketplace
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMarketplace
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMarketplace        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMarketplace          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMarketplace = fmt.Errorf("proto: unexpected end of group")
+)
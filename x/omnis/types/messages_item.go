@@ -0,0 +1,287 @@
+package types
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewMsgCreateItem(creator string, name string, alias string, namespace string, attributes []Attribute, expiresAt *time.Time, royalty *Royalty) *MsgCreateItem {
+	return &MsgCreateItem{
+		Creator:    creator,
+		Name:       name,
+		Alias:      alias,
+		Namespace:  namespace,
+		Attributes: attributes,
+		ExpiresAt:  expiresAt,
+		Royalty:    royalty,
+	}
+}
+
+func NewMsgUpdateItem(creator string, id uint64, newName string) *MsgUpdateItem {
+	return &MsgUpdateItem{
+		Creator: creator,
+		Id:      id,
+		NewName: newName,
+	}
+}
+
+func NewMsgDeleteItem(creator string, id uint64) *MsgDeleteItem {
+	return &MsgDeleteItem{
+		Creator: creator,
+		Id:      id,
+	}
+}
+
+func NewMsgTransferItem(creator string, id uint64, newOwner string, memo string) *MsgTransferItem {
+	return &MsgTransferItem{
+		Creator:  creator,
+		Id:       id,
+		NewOwner: newOwner,
+		Memo:     memo,
+	}
+}
+
+func NewMsgSetItemAttributes(creator string, id uint64, attributes []Attribute) *MsgSetItemAttributes {
+	return &MsgSetItemAttributes{
+		Creator:    creator,
+		Id:         id,
+		Attributes: attributes,
+	}
+}
+
+func NewMsgRemoveItemAttributes(creator string, id uint64, keys []string) *MsgRemoveItemAttributes {
+	return &MsgRemoveItemAttributes{
+		Creator: creator,
+		Id:      id,
+		Keys:    keys,
+	}
+}
+
+func NewMsgSetAttributeSchema(creator string, namespace string, definitions []AttributeDefinition) *MsgSetAttributeSchema {
+	return &MsgSetAttributeSchema{
+		Creator:     creator,
+		Namespace:   namespace,
+		Definitions: definitions,
+	}
+}
+
+func NewMsgCreateCollection(creator string, namespace string, description string, creationPolicy CreationPolicy, allowlist []string, maxItems uint64, maxItemsPerCreator uint64, expiryAction ExpiryAction, royalty *Royalty, attesters []string) *MsgCreateCollection {
+	return &MsgCreateCollection{
+		Creator:            creator,
+		Namespace:          namespace,
+		Description:        description,
+		CreationPolicy:     creationPolicy,
+		Allowlist:          allowlist,
+		MaxItems:           maxItems,
+		MaxItemsPerCreator: maxItemsPerCreator,
+		ExpiryAction:       expiryAction,
+		Royalty:            royalty,
+		Attesters:          attesters,
+	}
+}
+
+func NewMsgUpdateCollection(creator string, namespace string, newAdmin string, description string, creationPolicy CreationPolicy, allowlist []string, maxItems uint64, maxItemsPerCreator uint64, expiryAction ExpiryAction, royalty *Royalty, attesters []string) *MsgUpdateCollection {
+	return &MsgUpdateCollection{
+		Creator:            creator,
+		Namespace:          namespace,
+		NewAdmin:           newAdmin,
+		Description:        description,
+		CreationPolicy:     creationPolicy,
+		Allowlist:          allowlist,
+		MaxItems:           maxItems,
+		MaxItemsPerCreator: maxItemsPerCreator,
+		ExpiryAction:       expiryAction,
+		Royalty:            royalty,
+		Attesters:          attesters,
+	}
+}
+
+func NewMsgNotarize(creator string, namespace string, name string, alias string, contentHash ContentHash, uri string) *MsgNotarize {
+	return &MsgNotarize{
+		Creator:     creator,
+		Namespace:   namespace,
+		Name:        name,
+		Alias:       alias,
+		ContentHash: contentHash,
+		Uri:         uri,
+	}
+}
+
+func NewMsgSetItemExpiry(creator string, id uint64, expiresAt *time.Time) *MsgSetItemExpiry {
+	return &MsgSetItemExpiry{
+		Creator:   creator,
+		Id:        id,
+		ExpiresAt: expiresAt,
+	}
+}
+
+func NewMsgApproveItem(creator string, id uint64, operator string, expiresAt *time.Time) *MsgApproveItem {
+	return &MsgApproveItem{
+		Creator:   creator,
+		Id:        id,
+		Operator:  operator,
+		ExpiresAt: expiresAt,
+	}
+}
+
+func NewMsgRevokeItem(creator string, id uint64, operator string) *MsgRevokeItem {
+	return &MsgRevokeItem{
+		Creator:  creator,
+		Id:       id,
+		Operator: operator,
+	}
+}
+
+func NewMsgApproveAll(creator string, operator string, expiresAt *time.Time) *MsgApproveAll {
+	return &MsgApproveAll{
+		Creator:   creator,
+		Operator:  operator,
+		ExpiresAt: expiresAt,
+	}
+}
+
+func NewMsgRevokeAll(creator string, operator string) *MsgRevokeAll {
+	return &MsgRevokeAll{
+		Creator:  creator,
+		Operator: operator,
+	}
+}
+
+func NewMsgListItem(creator string, id uint64, price sdk.Coin) *MsgListItem {
+	return &MsgListItem{
+		Creator: creator,
+		Id:      id,
+		Price:   price,
+	}
+}
+
+func NewMsgDelistItem(creator string, id uint64) *MsgDelistItem {
+	return &MsgDelistItem{
+		Creator: creator,
+		Id:      id,
+	}
+}
+
+func NewMsgUpdateListingPrice(creator string, id uint64, price sdk.Coin) *MsgUpdateListingPrice {
+	return &MsgUpdateListingPrice{
+		Creator: creator,
+		Id:      id,
+		Price:   price,
+	}
+}
+
+func NewMsgBuyItem(creator string, id uint64, price sdk.Coin) *MsgBuyItem {
+	return &MsgBuyItem{
+		Creator: creator,
+		Id:      id,
+		Price:   price,
+	}
+}
+
+func NewMsgSetItemUser(creator string, id uint64, user string, expiresAt *time.Time) *MsgSetItemUser {
+	return &MsgSetItemUser{
+		Creator:   creator,
+		Id:        id,
+		User:      user,
+		ExpiresAt: expiresAt,
+	}
+}
+
+func NewMsgIBCTransferItems(creator, sourcePort, sourceChannel, namespace string, ids []uint64, receiver string, timeoutTimestamp uint64, memo string) *MsgIBCTransferItems {
+	return &MsgIBCTransferItems{
+		Creator:          creator,
+		SourcePort:       sourcePort,
+		SourceChannel:    sourceChannel,
+		Namespace:        namespace,
+		Ids:              ids,
+		Receiver:         receiver,
+		TimeoutTimestamp: timeoutTimestamp,
+		Memo:             memo,
+	}
+}
+
+func NewMsgFractionalizeItem(creator string, id uint64, name string, symbol string, supply math.Int, reservePrice sdk.Coin) *MsgFractionalizeItem {
+	return &MsgFractionalizeItem{
+		Creator:      creator,
+		Id:           id,
+		Name:         name,
+		Symbol:       symbol,
+		Supply:       supply,
+		ReservePrice: reservePrice,
+	}
+}
+
+func NewMsgRedeemFraction(creator string, id uint64) *MsgRedeemFraction {
+	return &MsgRedeemFraction{
+		Creator: creator,
+		Id:      id,
+	}
+}
+
+func NewMsgBuyoutItem(creator string, id uint64, price sdk.Coin) *MsgBuyoutItem {
+	return &MsgBuyoutItem{
+		Creator: creator,
+		Id:      id,
+		Price:   price,
+	}
+}
+
+func NewMsgClaimBuyoutProceeds(creator string, id uint64) *MsgClaimBuyoutProceeds {
+	return &MsgClaimBuyoutProceeds{
+		Creator: creator,
+		Id:      id,
+	}
+}
+
+func NewMsgBatchCreateItems(creator string, items []BatchItem) *MsgBatchCreateItems {
+	return &MsgBatchCreateItems{
+		Creator: creator,
+		Items:   items,
+	}
+}
+
+func NewMsgBatchUpdateItems(creator string, items []BatchItemUpdate) *MsgBatchUpdateItems {
+	return &MsgBatchUpdateItems{
+		Creator: creator,
+		Items:   items,
+	}
+}
+
+func NewMsgAttest(creator string, id uint64, claimType string, payloadHash ContentHash, expiresAt *time.Time) *MsgAttest {
+	return &MsgAttest{
+		Creator:     creator,
+		Id:          id,
+		ClaimType:   claimType,
+		PayloadHash: payloadHash,
+		ExpiresAt:   expiresAt,
+	}
+}
+
+func NewMsgRevokeAttestation(creator string, id uint64, attester string, claimType string) *MsgRevokeAttestation {
+	return &MsgRevokeAttestation{
+		Creator:   creator,
+		Id:        id,
+		Attester:  attester,
+		ClaimType: claimType,
+	}
+}
+
+func NewMsgSetItemLifecycle(creator string, namespace string, states []string, initialState string, transitions []LifecycleTransition) *MsgSetItemLifecycle {
+	return &MsgSetItemLifecycle{
+		Creator:      creator,
+		Namespace:    namespace,
+		States:       states,
+		InitialState: initialState,
+		Transitions:  transitions,
+	}
+}
+
+func NewMsgTransitionItem(creator string, id uint64, toState string) *MsgTransitionItem {
+	return &MsgTransitionItem{
+		Creator: creator,
+		Id:      id,
+		ToState: toState,
+	}
+}
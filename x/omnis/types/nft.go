@@ -0,0 +1,58 @@
+package types
+
+import (
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/x/nft"
+)
+
+// NFTClassPrefix prefixes the ids of the NFT classes mirroring item
+// collections.
+const NFTClassPrefix = ModuleName + "/"
+
+// NFTClassID returns the id of the NFT class mirroring a collection.
+func NFTClassID(namespace string) string {
+	return NFTClassPrefix + namespace
+}
+
+// NFTID returns the id of the NFT mirroring an item.
+func NFTID(id uint64) string {
+	return strconv.FormatUint(id, 10)
+}
+
+// ParseNFT returns the id of the item an NFT mirrors, and false if the NFT does
+// not mirror an item.
+func ParseNFT(classID, nftID string) (uint64, bool) {
+	if !strings.HasPrefix(classID, NFTClassPrefix) {
+		return 0, false
+	}
+	id, err := strconv.ParseUint(nftID, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return id, true
+}
+
+// NFTClass returns the NFT class mirroring a collection.
+func (c ItemCollection) NFTClass() nft.Class {
+	return nft.Class{
+		Id:          NFTClassID(c.Namespace),
+		Name:        c.Namespace,
+		Description: c.Description,
+	}
+}
+
+// NFT returns the NFT mirroring an item. The uri and content hash of
+// notarized items are carried over.
+func (i Item) NFT() nft.NFT {
+	token := nft.NFT{
+		ClassId: NFTClassID(i.Namespace),
+		Id:      NFTID(i.Id),
+		Uri:     i.Uri,
+	}
+	if i.ContentHash != nil {
+		token.UriHash = i.ContentHash.Hash
+	}
+	return token
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// MaxItemURILength is the maximum length of the URI of a notarized item.
+const MaxItemURILength = 512
+
+// Size returns the digest size in bytes of the hash algorithm, or 0 if it is
+// not supported.
+func (a HashAlgorithm) Size() int {
+	switch a {
+	case HASH_ALGORITHM_SHA256, HASH_ALGORITHM_BLAKE2B_256:
+		return 32
+	default:
+		return 0
+	}
+}
+
+// Validate checks that the hash is a lowercase hex digest of the size of its
+// algorithm.
+func (h ContentHash) Validate() error {
+	size := h.Algorithm.Size()
+	if size == 0 {
+		return errorsmod.Wrapf(ErrInvalidContentHash, "unsupported hash algorithm %s", h.Algorithm)
+	}
+	if h.Hash != strings.ToLower(h.Hash) {
+		return errorsmod.Wrap(ErrInvalidContentHash, "hash must be lowercase hex")
+	}
+	digest, err := hex.DecodeString(h.Hash)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidContentHash, "hash must be lowercase hex: %s", err)
+	}
+	if len(digest) != size {
+		return errorsmod.Wrapf(ErrInvalidContentHash, "%s digest must be %d bytes, got %d", h.Algorithm, size, len(digest))
+	}
+	return nil
+}
+
+// IndexKey returns the key of the hash in the content hash index.
+func (h ContentHash) IndexKey() string {
+	return fmt.Sprintf("%d/%s", h.Algorithm, h.Hash)
+}
+
+// ValidateItemURI checks the optional URI of a notarized item.
+func ValidateItemURI(uri string) error {
+	if uri == "" {
+		return nil
+	}
+	if len(uri) > MaxItemURILength {
+		return errorsmod.Wrapf(ErrInvalidURI, "uri is longer than %d characters", MaxItemURILength)
+	}
+	if _, err := url.ParseRequestURI(uri); err != nil {
+		return errorsmod.Wrapf(ErrInvalidURI, "%s", err)
+	}
+	return nil
+}
@@ -0,0 +1,752 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: omnis/omnis/v1/notarization.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// HashAlgorithm is the algorithm a content hash was computed with.
+type HashAlgorithm int32
+
+const (
+	HASH_ALGORITHM_UNSPECIFIED HashAlgorithm = 0
+	HASH_ALGORITHM_SHA256      HashAlgorithm = 1
+	HASH_ALGORITHM_BLAKE2B_256 HashAlgorithm = 2
+)
+
+var HashAlgorithm_name = map[int32]string{
+	0: "HASH_ALGORITHM_UNSPECIFIED",
+	1: "HASH_ALGORITHM_SHA256",
+	2: "HASH_ALGORITHM_BLAKE2B_256",
+}
+
+var HashAlgorithm_value = map[string]int32{
+	"HASH_ALGORITHM_UNSPECIFIED": 0,
+	"HASH_ALGORITHM_SHA256":      1,
+	"HASH_ALGORITHM_BLAKE2B_256": 2,
+}
+
+func (x HashAlgorithm) String() string {
+	return proto.EnumName(HashAlgorithm_name, int32(x))
+}
+
+func (HashAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_de4666d8127b05b2, []int{0}
+}
+
+// ContentHash is the hash of an off-chain document.
+type ContentHash struct {
+	Algorithm HashAlgorithm `protobuf:"varint,1,opt,name=algorithm,proto3,enum=omnis.omnis.v1.HashAlgorithm" json:"algorithm,omitempty"`
+	// hash is the lowercase hex encoding of the digest.
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *ContentHash) Reset()         { *m = ContentHash{} }
+func (m *ContentHash) String() string { return proto.CompactTextString(m) }
+func (*ContentHash) ProtoMessage()    {}
+func (*ContentHash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de4666d8127b05b2, []int{0}
+}
+func (m *ContentHash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContentHash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContentHash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContentHash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContentHash.Merge(m, src)
+}
+func (m *ContentHash) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContentHash) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContentHash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContentHash proto.InternalMessageInfo
+
+func (m *ContentHash) GetAlgorithm() HashAlgorithm {
+	if m != nil {
+		return m.Algorithm
+	}
+	return HASH_ALGORITHM_UNSPECIFIED
+}
+
+func (m *ContentHash) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+// Notarization records the first registration of a content hash.
+type Notarization struct {
+	ContentHash ContentHash `protobuf:"bytes,1,opt,name=content_hash,json=contentHash,proto3" json:"content_hash"`
+	// item_id is the item created by the first registration. The notarization
+	// outlives the item.
+	ItemId uint64    `protobuf:"varint,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Notary string    `protobuf:"bytes,3,opt,name=notary,proto3" json:"notary,omitempty"`
+	Height int64     `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Time   time.Time `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *Notarization) Reset()         { *m = Notarization{} }
+func (m *Notarization) String() string { return proto.CompactTextString(m) }
+func (*Notarization) ProtoMessage()    {}
+func (*Notarization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de4666d8127b05b2, []int{1}
+}
+func (m *Notarization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Notarization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Notarization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Notarization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Notarization.Merge(m, src)
+}
+func (m *Notarization) XXX_Size() int {
+	return m.Size()
+}
+func (m *Notarization) XXX_DiscardUnknown() {
+	xxx_messageInfo_Notarization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Notarization proto.InternalMessageInfo
+
+func (m *Notarization) GetContentHash() ContentHash {
+	if m != nil {
+		return m.ContentHash
+	}
+	return ContentHash{}
+}
+
+func (m *Notarization) GetItemId() uint64 {
+	if m != nil {
+		return m.ItemId
+	}
+	return 0
+}
+
+func (m *Notarization) GetNotary() string {
+	if m != nil {
+		return m.Notary
+	}
+	return ""
+}
+
+func (m *Notarization) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Notarization) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterEnum("omnis.omnis.v1.HashAlgorithm", HashAlgorithm_name, HashAlgorithm_value)
+	proto.RegisterType((*ContentHash)(nil), "omnis.omnis.v1.ContentHash")
+	proto.RegisterType((*Notarization)(nil), "omnis.omnis.v1.Notarization")
+}
+
+func init() { proto.RegisterFile("omnis/omnis/v1/notarization.proto", fileDescriptor_de4666d8127b05b2) }
+
+var fileDescriptor_de4666d8127b05b2 = []byte{
+	// 444 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0x41, 0x6e, 0xd4, 0x30,
+	0x14, 0x8d, 0xdb, 0x30, 0x50, 0x4f, 0xa9, 0x2a, 0x53, 0x20, 0x0d, 0xc2, 0x33, 0x74, 0x35, 0x42,
+	0x6a, 0x42, 0x07, 0x81, 0x90, 0x58, 0x25, 0xed, 0x40, 0x22, 0x4a, 0x41, 0x4e, 0xd9, 0xb0, 0x20,
+	0x4a, 0x27, 0x21, 0xb1, 0xd4, 0xc4, 0x51, 0x6c, 0x2a, 0xca, 0x09, 0x58, 0xf6, 0x0e, 0x5c, 0x81,
+	0x43, 0x74, 0x59, 0xb1, 0x62, 0x05, 0x68, 0xe6, 0x04, 0xdc, 0x00, 0xd9, 0xce, 0x88, 0xe9, 0xa8,
+	0x1b, 0xcb, 0xcf, 0xef, 0x59, 0xef, 0xfd, 0xa7, 0x0f, 0x1f, 0xb0, 0xb2, 0xa2, 0xdc, 0xd5, 0xe7,
+	0xc9, 0x8e, 0x5b, 0x31, 0x91, 0x34, 0xf4, 0x4b, 0x22, 0x28, 0xab, 0x9c, 0xba, 0x61, 0x82, 0xa1,
+	0x35, 0x45, 0x3a, 0xfa, 0x3c, 0xd9, 0xb1, 0x37, 0xc7, 0x8c, 0x97, 0x8c, 0xc7, 0x8a, 0x75, 0x35,
+	0xd0, 0x52, 0x7b, 0x23, 0x67, 0x39, 0xd3, 0xef, 0xf2, 0xd6, 0xbe, 0xf6, 0x72, 0xc6, 0xf2, 0xe3,
+	0xcc, 0x55, 0xe8, 0xe8, 0xd3, 0x47, 0x57, 0xd0, 0x32, 0xe3, 0x22, 0x29, 0x6b, 0x2d, 0xd8, 0xfa,
+	0x00, 0xbb, 0xbb, 0xac, 0x12, 0x59, 0x25, 0x82, 0x84, 0x17, 0xe8, 0x39, 0x5c, 0x49, 0x8e, 0x73,
+	0xd6, 0x50, 0x51, 0x94, 0x16, 0xe8, 0x83, 0xc1, 0xda, 0xf0, 0xbe, 0x73, 0x39, 0x84, 0x23, 0x85,
+	0xde, 0x4c, 0x44, 0xfe, 0xeb, 0x11, 0x82, 0x66, 0x91, 0xf0, 0xc2, 0x5a, 0xea, 0x83, 0xc1, 0x0a,
+	0x51, 0xf7, 0xad, 0xbf, 0x00, 0xae, 0x1e, 0xcc, 0x0d, 0x86, 0xf6, 0xe0, 0xea, 0x58, 0x1b, 0xc6,
+	0x4a, 0x2c, 0x4d, 0xba, 0xc3, 0x7b, 0x8b, 0x26, 0x73, 0xa1, 0x7c, 0xf3, 0xfc, 0x57, 0xcf, 0x20,
+	0xdd, 0xf1, 0x5c, 0xce, 0xbb, 0xf0, 0x3a, 0x15, 0x59, 0x19, 0xd3, 0x54, 0xb9, 0x99, 0xa4, 0x23,
+	0x61, 0x98, 0xa2, 0x47, 0xb0, 0xa3, 0x7a, 0x3c, 0xb5, 0x96, 0x65, 0x0a, 0xdf, 0xfa, 0xf1, 0x7d,
+	0x7b, 0xa3, 0x2d, 0xca, 0x4b, 0xd3, 0x26, 0xe3, 0x3c, 0x12, 0x0d, 0xad, 0x72, 0xd2, 0xea, 0xd0,
+	0x1d, 0xd8, 0x29, 0x32, 0x9a, 0x17, 0xc2, 0x32, 0xfb, 0x60, 0xb0, 0x4c, 0x5a, 0x84, 0x9e, 0x41,
+	0x53, 0x96, 0x65, 0x5d, 0x53, 0x01, 0x6d, 0x47, 0x37, 0xe9, 0xcc, 0x9a, 0x74, 0x0e, 0x67, 0x4d,
+	0xfa, 0x37, 0x64, 0xbe, 0xb3, 0xdf, 0x3d, 0x40, 0xd4, 0x8f, 0x87, 0x35, 0xbc, 0x79, 0xa9, 0x23,
+	0x84, 0xa1, 0x1d, 0x78, 0x51, 0x10, 0x7b, 0xfb, 0x2f, 0xdf, 0x90, 0xf0, 0x30, 0x78, 0x1d, 0xbf,
+	0x3b, 0x88, 0xde, 0x8e, 0x76, 0xc3, 0x17, 0xe1, 0x68, 0x6f, 0xdd, 0x40, 0x9b, 0xf0, 0xf6, 0x02,
+	0x1f, 0x05, 0xde, 0xf0, 0xc9, 0xd3, 0x75, 0x70, 0xc5, 0x57, 0x7f, 0xdf, 0x7b, 0x35, 0x1a, 0xfa,
+	0xb1, 0xe4, 0x97, 0x6c, 0xf3, 0xeb, 0x37, 0x6c, 0xf8, 0xdb, 0xe7, 0x13, 0x0c, 0x2e, 0x26, 0x18,
+	0xfc, 0x99, 0x60, 0x70, 0x36, 0xc5, 0xc6, 0xc5, 0x14, 0x1b, 0x3f, 0xa7, 0xd8, 0x78, 0x7f, 0x4b,
+	0xaf, 0xd7, 0xe7, 0x76, 0xcd, 0xc4, 0x69, 0x9d, 0xf1, 0xa3, 0x8e, 0x1a, 0xe2, 0xf1, 0xbf, 0x00,
+	0x00, 0x00, 0xff, 0xff, 0x5c, 0xd8, 0xfd, 0xb0, 0x82, 0x02, 0x00, 0x00,
+}
+
+func (m *ContentHash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContentHash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContentHash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintNotarization(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Algorithm != 0 {
+		i = encodeVarintNotarization(dAtA, i, uint64(m.Algorithm))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Notarization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Notarization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Notarization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintNotarization(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintNotarization(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Notary) > 0 {
+		i -= len(m.Notary)
+		copy(dAtA[i:], m.Notary)
+		i = encodeVarintNotarization(dAtA, i, uint64(len(m.Notary)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ItemId != 0 {
+		i = encodeVarintNotarization(dAtA, i, uint64(m.ItemId))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.ContentHash.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintNotarization(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintNotarization(dAtA []byte, offset int, v uint64) int {
+	offset -= sovNotarization(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ContentHash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Algorithm != 0 {
+		n += 1 + sovNotarization(uint64(m.Algorithm))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovNotarization(uint64(l))
+	}
+	return n
+}
+
+func (m *Notarization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ContentHash.Size()
+	n += 1 + l + sovNotarization(uint64(l))
+	if m.ItemId != 0 {
+		n += 1 + sovNotarization(uint64(m.ItemId))
+	}
+	l = len(m.Notary)
+	if l > 0 {
+		n += 1 + l + sovNotarization(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovNotarization(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovNotarization(uint64(l))
+	return n
+}
+
+func sovNotarization(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozNotarization(x uint64) (n int) {
+	return sovNotarization(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ContentHash) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNotarization
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContentHash: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContentHash: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Algorithm", wireType)
+			}
+			m.Algorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotarization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Algorithm |= HashAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotarization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNotarization
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotarization
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNotarization(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNotarization
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Notarization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNotarization
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Notarization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Notarization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentHash", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotarization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNotarization
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotarization
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ContentHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemId", wireType)
+			}
+			m.ItemId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotarization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ItemId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Notary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotarization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNotarization
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotarization
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Notary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotarization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotarization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNotarization
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotarization
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNotarization(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNotarization
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipNotarization(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowNotarization
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowNotarization
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowNotarization
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthNotarization
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupNotarization
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthNotarization
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthNotarization        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowNotarization          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupNotarization = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,17 +1,90 @@
 package types
 
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultMaxItemRevisions is the default number of revisions kept per item.
+	DefaultMaxItemRevisions uint64 = 100
+	// DefaultMaxExpirationsPerBlock is the default number of expired items
+	// processed per block.
+	DefaultMaxExpirationsPerBlock uint64 = 100
+	// DefaultMarketplaceFee is the default share of marketplace sales paid to
+	// the fee collector.
+	DefaultMarketplaceFee = "0"
+	// DefaultMaxBatchSize is the default number of items per batch message.
+	DefaultMaxBatchSize uint64 = 100
+)
+
 // NewParams creates a new Params instance.
-func NewParams() Params {
-	return Params{}
+func NewParams(maxItemRevisions uint64, maxExpirationsPerBlock uint64, marketplaceFee string, marketplaceDenoms []string, maxBatchSize uint64) Params {
+	return Params{
+		MaxItemRevisions:       maxItemRevisions,
+		MaxExpirationsPerBlock: maxExpirationsPerBlock,
+		MarketplaceFee:         marketplaceFee,
+		MarketplaceDenoms:      marketplaceDenoms,
+		MaxBatchSize:           maxBatchSize,
+	}
 }
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(DefaultMaxItemRevisions, DefaultMaxExpirationsPerBlock, DefaultMarketplaceFee, []string{sdk.DefaultBondDenom}, DefaultMaxBatchSize)
 }
 
 // Validate validates the set of params.
 func (p Params) Validate() error {
+	if p.MaxItemRevisions == 0 {
+		return fmt.Errorf("max item revisions must be positive")
+	}
+	if p.MaxExpirationsPerBlock == 0 {
+		return fmt.Errorf("max expirations per block must be positive")
+	}
+	if p.MaxBatchSize == 0 {
+		return fmt.Errorf("max batch size must be positive")
+	}
+
+	fee, err := p.MarketplaceFeeDec()
+	if err != nil {
+		return err
+	}
+	if fee.IsNegative() || fee.GTE(math.LegacyOneDec()) {
+		return fmt.Errorf("marketplace fee must be in [0, 1), got %s", fee)
+	}
+
+	seen := make(map[string]bool, len(p.MarketplaceDenoms))
+	for _, denom := range p.MarketplaceDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid marketplace denom: %w", err)
+		}
+		if seen[denom] {
+			return fmt.Errorf("duplicated marketplace denom %s", denom)
+		}
+		seen[denom] = true
+	}
 
 	return nil
 }
+
+// MarketplaceFeeDec returns the marketplace fee as a decimal.
+func (p Params) MarketplaceFeeDec() (math.LegacyDec, error) {
+	fee, err := math.LegacyNewDecFromStr(p.MarketplaceFee)
+	if err != nil {
+		return math.LegacyDec{}, fmt.Errorf("invalid marketplace fee: %w", err)
+	}
+	return fee, nil
+}
+
+// IsMarketplaceDenom returns whether items can be listed in the given denom.
+func (p Params) IsMarketplaceDenom(denom string) bool {
+	for _, d := range p.MarketplaceDenoms {
+		if d == denom {
+			return true
+		}
+	}
+	return false
+}
@@ -26,6 +26,21 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// max_item_revisions is the number of revisions kept in the history of an
+	// item. Older revisions are pruned when the item next changes.
+	MaxItemRevisions uint64 `protobuf:"varint,1,opt,name=max_item_revisions,json=maxItemRevisions,proto3" json:"max_item_revisions,omitempty"`
+	// max_expirations_per_block caps the number of expired items processed at
+	// the end of a block. The remaining ones are processed in the next blocks.
+	MaxExpirationsPerBlock uint64 `protobuf:"varint,2,opt,name=max_expirations_per_block,json=maxExpirationsPerBlock,proto3" json:"max_expirations_per_block,omitempty"`
+	// marketplace_fee is the share of the price of every marketplace sale paid
+	// to the fee collector, as a decimal string in [0, 1).
+	MarketplaceFee string `protobuf:"bytes,3,opt,name=marketplace_fee,json=marketplaceFee,proto3" json:"marketplace_fee,omitempty"`
+	// marketplace_denoms lists the native and OMS-20 denoms items can be listed
+	// in.
+	MarketplaceDenoms []string `protobuf:"bytes,4,rep,name=marketplace_denoms,json=marketplaceDenoms,proto3" json:"marketplace_denoms,omitempty"`
+	// max_batch_size caps the number of items created or updated by a single
+	// batch message.
+	MaxBatchSize uint64 `protobuf:"varint,5,opt,name=max_batch_size,json=maxBatchSize,proto3" json:"max_batch_size,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -61,6 +76,41 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetMaxItemRevisions() uint64 {
+	if m != nil {
+		return m.MaxItemRevisions
+	}
+	return 0
+}
+
+func (m *Params) GetMaxExpirationsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxExpirationsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMarketplaceFee() string {
+	if m != nil {
+		return m.MarketplaceFee
+	}
+	return ""
+}
+
+func (m *Params) GetMarketplaceDenoms() []string {
+	if m != nil {
+		return m.MarketplaceDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetMaxBatchSize() uint64 {
+	if m != nil {
+		return m.MaxBatchSize
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "omnis.omnis.v1.Params")
 }
@@ -68,17 +118,27 @@ func init() {
 func init() { proto.RegisterFile("omnis/omnis/v1/params.proto", fileDescriptor_76790f3b8d316454) }
 
 var fileDescriptor_76790f3b8d316454 = []byte{
-	// 149 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xce, 0xcf, 0xcd, 0xcb,
-	0x2c, 0xd6, 0x87, 0x90, 0x65, 0x86, 0xfa, 0x05, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x7a, 0x05, 0x45,
-	0xf9, 0x25, 0xf9, 0x42, 0x7c, 0x60, 0x61, 0x3d, 0x08, 0x59, 0x66, 0x28, 0x25, 0x98, 0x98, 0x9b,
-	0x99, 0x97, 0xaf, 0x0f, 0x26, 0x21, 0x4a, 0xa4, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x4c, 0x7d,
-	0x10, 0x0b, 0x22, 0xaa, 0xa4, 0xce, 0xc5, 0x16, 0x00, 0x36, 0xc8, 0x4a, 0xf6, 0xc5, 0x02, 0x79,
-	0xc6, 0xae, 0xe7, 0x1b, 0xb4, 0x44, 0x20, 0x56, 0x54, 0x40, 0xad, 0x82, 0x48, 0x3b, 0xe9, 0x9e,
-	0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31,
-	0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x30, 0xaa, 0xfa, 0x92, 0xca, 0x82,
-	0xd4, 0xe2, 0x24, 0x36, 0xb0, 0xf1, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x2c, 0xe5,
-	0xde, 0xb6, 0x00, 0x00, 0x00,
+	// 319 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xc1, 0x4a, 0x42, 0x41,
+	0x18, 0x85, 0x1d, 0x35, 0xc1, 0x4b, 0x58, 0x4e, 0x12, 0x37, 0xa3, 0x9b, 0x44, 0x90, 0x44, 0x7a,
+	0x91, 0x56, 0xb5, 0x94, 0x0a, 0xda, 0xc9, 0x6d, 0xd7, 0xe6, 0x32, 0xda, 0x9f, 0x0d, 0x3a, 0x77,
+	0x86, 0x99, 0x41, 0x26, 0x1f, 0xa1, 0x45, 0xf4, 0x08, 0x3d, 0x42, 0x8f, 0xd1, 0xd2, 0x65, 0xcb,
+	0xd0, 0x45, 0x3d, 0x46, 0xcc, 0x4c, 0xa1, 0x6d, 0x0e, 0x3f, 0xdf, 0x39, 0x33, 0x1c, 0x4e, 0xb0,
+	0xcb, 0x59, 0x46, 0x55, 0xec, 0x75, 0xd2, 0x89, 0x05, 0x91, 0x84, 0xa9, 0xb6, 0x90, 0x5c, 0x73,
+	0x5c, 0x71, 0xb8, 0xed, 0x75, 0xd2, 0xa9, 0x57, 0x09, 0xa3, 0x19, 0x8f, 0x9d, 0xfa, 0x48, 0xbd,
+	0x36, 0xe4, 0x43, 0xee, 0xce, 0xd8, 0x5e, 0x9e, 0x1e, 0x3c, 0xe7, 0x83, 0x52, 0xcf, 0xfd, 0x84,
+	0x4f, 0x02, 0xcc, 0x88, 0x49, 0xa9, 0x06, 0x96, 0x4a, 0x98, 0x50, 0x45, 0x79, 0xa6, 0x42, 0xd4,
+	0x40, 0xcd, 0x62, 0xb2, 0xc9, 0x88, 0xb9, 0xd6, 0xc0, 0x92, 0x3f, 0x8e, 0xcf, 0x82, 0x1d, 0x9b,
+	0x06, 0x23, 0xa8, 0x24, 0xda, 0xa2, 0x54, 0x80, 0x4c, 0xfb, 0x63, 0x3e, 0x18, 0x85, 0x79, 0xf7,
+	0x68, 0x9b, 0x11, 0x73, 0xb9, 0xf4, 0x7b, 0x20, 0xbb, 0xd6, 0xc5, 0x47, 0xc1, 0x06, 0x23, 0x72,
+	0x04, 0x5a, 0x8c, 0xc9, 0x00, 0xd2, 0x7b, 0x80, 0xb0, 0xd0, 0x40, 0xcd, 0x72, 0x52, 0x59, 0xc1,
+	0x57, 0x00, 0xb8, 0x65, 0x1b, 0x2d, 0x83, 0x77, 0x90, 0x71, 0xa6, 0xc2, 0x62, 0xa3, 0xd0, 0x2c,
+	0x27, 0xd5, 0x15, 0xe7, 0xc2, 0x19, 0xf8, 0x30, 0xa8, 0xd8, 0x4a, 0x7d, 0xa2, 0x07, 0x0f, 0xa9,
+	0xa2, 0x53, 0x08, 0xd7, 0x5c, 0x8f, 0x75, 0x46, 0x4c, 0xd7, 0xc2, 0x1b, 0x3a, 0x85, 0xf3, 0xbd,
+	0xef, 0xd7, 0x7d, 0xf4, 0xf4, 0xf5, 0x76, 0x5c, 0xf3, 0x53, 0x9a, 0xdf, 0x49, 0xfd, 0x0a, 0xdd,
+	0xd6, 0xfb, 0x3c, 0x42, 0xb3, 0x79, 0x84, 0x3e, 0xe7, 0x11, 0x7a, 0x59, 0x44, 0xb9, 0xd9, 0x22,
+	0xca, 0x7d, 0x2c, 0xa2, 0xdc, 0xed, 0xd6, 0xff, 0xbc, 0x7e, 0x14, 0xa0, 0xfa, 0x25, 0x37, 0xe3,
+	0xe9, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2b, 0x9a, 0x25, 0x18, 0x9e, 0x01, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -100,6 +160,26 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.MaxItemRevisions != that1.MaxItemRevisions {
+		return false
+	}
+	if this.MaxExpirationsPerBlock != that1.MaxExpirationsPerBlock {
+		return false
+	}
+	if this.MarketplaceFee != that1.MarketplaceFee {
+		return false
+	}
+	if len(this.MarketplaceDenoms) != len(that1.MarketplaceDenoms) {
+		return false
+	}
+	for i := range this.MarketplaceDenoms {
+		if this.MarketplaceDenoms[i] != that1.MarketplaceDenoms[i] {
+			return false
+		}
+	}
+	if this.MaxBatchSize != that1.MaxBatchSize {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -122,6 +202,37 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxBatchSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBatchSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.MarketplaceDenoms) > 0 {
+		for iNdEx := len(m.MarketplaceDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MarketplaceDenoms[iNdEx])
+			copy(dAtA[i:], m.MarketplaceDenoms[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.MarketplaceDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.MarketplaceFee) > 0 {
+		i -= len(m.MarketplaceFee)
+		copy(dAtA[i:], m.MarketplaceFee)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MarketplaceFee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MaxExpirationsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxExpirationsPerBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxItemRevisions != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxItemRevisions))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -142,6 +253,25 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.MaxItemRevisions != 0 {
+		n += 1 + sovParams(uint64(m.MaxItemRevisions))
+	}
+	if m.MaxExpirationsPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxExpirationsPerBlock))
+	}
+	l = len(m.MarketplaceFee)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.MarketplaceDenoms) > 0 {
+		for _, s := range m.MarketplaceDenoms {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxBatchSize != 0 {
+		n += 1 + sovParams(uint64(m.MaxBatchSize))
+	}
 	return n
 }
 
@@ -180,6 +310,127 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxItemRevisions", wireType)
+			}
+			m.MaxItemRevisions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxItemRevisions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExpirationsPerBlock", wireType)
+			}
+			m.MaxExpirationsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExpirationsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketplaceFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketplaceFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketplaceDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketplaceDenoms = append(m.MarketplaceDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchSize", wireType)
+			}
+			m.MaxBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBatchSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
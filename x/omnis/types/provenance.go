@@ -0,0 +1,37 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxCustodyMemoLength is the maximum length of the memo of a custody record.
+const MaxCustodyMemoLength = 256
+
+// ValidateCustodyMemo checks the length of the memo of a custody change.
+func ValidateCustodyMemo(memo string) error {
+	if len(memo) > MaxCustodyMemoLength {
+		return errorsmod.Wrapf(sdkerrors.ErrMemoTooLarge, "memo is longer than %d characters", MaxCustodyMemoLength)
+	}
+	return nil
+}
+
+// Validate performs basic validation of the custody record.
+func (r CustodyRecord) Validate() error {
+	if _, ok := CustodyReason_name[int32(r.Reason)]; !ok || r.Reason == CUSTODY_REASON_UNSPECIFIED {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid custody reason %s", r.Reason)
+	}
+	if r.From == "" && r.To == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "custody record without custodian")
+	}
+	for _, addr := range []string{r.From, r.To} {
+		if addr == "" {
+			continue
+		}
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid custodian %s: %s", addr, err)
+		}
+	}
+	return ValidateCustodyMemo(r.Memo)
+}
@@ -0,0 +1,728 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: omnis/omnis/v1/provenance.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CustodyReason is the cause of a custody change of an item.
+type CustodyReason int32
+
+const (
+	CUSTODY_REASON_UNSPECIFIED CustodyReason = 0
+	// CUSTODY_REASON_CREATED is the creation of the item, including vouchers of
+	// items received over ICS-721.
+	CUSTODY_REASON_CREATED CustodyReason = 1
+	// CUSTODY_REASON_TRANSFER is a transfer by the owner or an approved
+	// operator.
+	CUSTODY_REASON_TRANSFER CustodyReason = 2
+	// CUSTODY_REASON_SALE is a purchase on the marketplace.
+	CUSTODY_REASON_SALE CustodyReason = 3
+	// CUSTODY_REASON_FRACTIONALIZED is the escrow of a fractionalized item.
+	CUSTODY_REASON_FRACTIONALIZED CustodyReason = 4
+	// CUSTODY_REASON_REDEEMED is the redemption of a fractionalized item by
+	// the holder of its whole supply.
+	CUSTODY_REASON_REDEEMED CustodyReason = 5
+	// CUSTODY_REASON_BUYOUT is the buyout of a fractionalized item.
+	CUSTODY_REASON_BUYOUT CustodyReason = 6
+	// CUSTODY_REASON_IBC_SEND is an item sent over ICS-721, escrowed or burnt.
+	CUSTODY_REASON_IBC_SEND CustodyReason = 7
+	// CUSTODY_REASON_IBC_RECEIVE is an item coming back over ICS-721,
+	// released from escrow.
+	CUSTODY_REASON_IBC_RECEIVE CustodyReason = 8
+	// CUSTODY_REASON_IBC_REFUND is an item returned to its sender when an
+	// ICS-721 packet failed.
+	CUSTODY_REASON_IBC_REFUND CustodyReason = 9
+	// CUSTODY_REASON_NFT_TRANSFER is a transfer of the NFT mirroring the item
+	// through x/nft.
+	CUSTODY_REASON_NFT_TRANSFER CustodyReason = 10
+	// CUSTODY_REASON_DELETED is the deletion of the item by its owner.
+	CUSTODY_REASON_DELETED CustodyReason = 11
+	// CUSTODY_REASON_EXPIRED is the deletion of an expired item.
+	CUSTODY_REASON_EXPIRED CustodyReason = 12
+	// CUSTODY_REASON_MIGRATED is the custody of an item created before
+	// custody changes were recorded, as of the upgrade that started recording
+	// them.
+	CUSTODY_REASON_MIGRATED CustodyReason = 13
+)
+
+var CustodyReason_name = map[int32]string{
+	0:  "CUSTODY_REASON_UNSPECIFIED",
+	1:  "CUSTODY_REASON_CREATED",
+	2:  "CUSTODY_REASON_TRANSFER",
+	3:  "CUSTODY_REASON_SALE",
+	4:  "CUSTODY_REASON_FRACTIONALIZED",
+	5:  "CUSTODY_REASON_REDEEMED",
+	6:  "CUSTODY_REASON_BUYOUT",
+	7:  "CUSTODY_REASON_IBC_SEND",
+	8:  "CUSTODY_REASON_IBC_RECEIVE",
+	9:  "CUSTODY_REASON_IBC_REFUND",
+	10: "CUSTODY_REASON_NFT_TRANSFER",
+	11: "CUSTODY_REASON_DELETED",
+	12: "CUSTODY_REASON_EXPIRED",
+	13: "CUSTODY_REASON_MIGRATED",
+}
+
+var CustodyReason_value = map[string]int32{
+	"CUSTODY_REASON_UNSPECIFIED":    0,
+	"CUSTODY_REASON_CREATED":        1,
+	"CUSTODY_REASON_TRANSFER":       2,
+	"CUSTODY_REASON_SALE":           3,
+	"CUSTODY_REASON_FRACTIONALIZED": 4,
+	"CUSTODY_REASON_REDEEMED":       5,
+	"CUSTODY_REASON_BUYOUT":         6,
+	"CUSTODY_REASON_IBC_SEND":       7,
+	"CUSTODY_REASON_IBC_RECEIVE":    8,
+	"CUSTODY_REASON_IBC_REFUND":     9,
+	"CUSTODY_REASON_NFT_TRANSFER":   10,
+	"CUSTODY_REASON_DELETED":        11,
+	"CUSTODY_REASON_EXPIRED":        12,
+	"CUSTODY_REASON_MIGRATED":       13,
+}
+
+func (x CustodyReason) String() string {
+	return proto.EnumName(CustodyReason_name, int32(x))
+}
+
+func (CustodyReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_43b707d868e7a9ae, []int{0}
+}
+
+// CustodyRecord is an entry of the append-only chain of custody of an item.
+type CustodyRecord struct {
+	ItemId uint64 `protobuf:"varint,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	// sequence is the position of the record in the chain of custody of the
+	// item, starting at 0.
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// from is the previous custodian, empty for the creation.
+	From string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	// to is the new custodian, empty when the item leaves the chain or is
+	// deleted.
+	To     string        `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Height int64         `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	Time   time.Time     `protobuf:"bytes,6,opt,name=time,proto3,stdtime" json:"time"`
+	Reason CustodyReason `protobuf:"varint,7,opt,name=reason,proto3,enum=omnis.omnis.v1.CustodyReason" json:"reason,omitempty"`
+	Memo   string        `protobuf:"bytes,8,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (m *CustodyRecord) Reset()         { *m = CustodyRecord{} }
+func (m *CustodyRecord) String() string { return proto.CompactTextString(m) }
+func (*CustodyRecord) ProtoMessage()    {}
+func (*CustodyRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43b707d868e7a9ae, []int{0}
+}
+func (m *CustodyRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CustodyRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CustodyRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CustodyRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CustodyRecord.Merge(m, src)
+}
+func (m *CustodyRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *CustodyRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_CustodyRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CustodyRecord proto.InternalMessageInfo
+
+func (m *CustodyRecord) GetItemId() uint64 {
+	if m != nil {
+		return m.ItemId
+	}
+	return 0
+}
+
+func (m *CustodyRecord) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *CustodyRecord) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *CustodyRecord) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *CustodyRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *CustodyRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *CustodyRecord) GetReason() CustodyReason {
+	if m != nil {
+		return m.Reason
+	}
+	return CUSTODY_REASON_UNSPECIFIED
+}
+
+func (m *CustodyRecord) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("omnis.omnis.v1.CustodyReason", CustodyReason_name, CustodyReason_value)
+	proto.RegisterType((*CustodyRecord)(nil), "omnis.omnis.v1.CustodyRecord")
+}
+
+func init() { proto.RegisterFile("omnis/omnis/v1/provenance.proto", fileDescriptor_43b707d868e7a9ae) }
+
+var fileDescriptor_43b707d868e7a9ae = []byte{
+	// 557 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xc1, 0x6e, 0x9b, 0x30,
+	0x1c, 0xc6, 0x21, 0xa1, 0x24, 0x75, 0xd7, 0x0a, 0xb9, 0x5d, 0x43, 0xa8, 0x42, 0xb2, 0x9d, 0xd0,
+	0xb4, 0x12, 0xb5, 0xd3, 0xa4, 0x5d, 0x09, 0x38, 0x13, 0x52, 0x4a, 0x2a, 0x43, 0xa6, 0xb5, 0x97,
+	0x28, 0x0d, 0x2e, 0x45, 0x1a, 0x38, 0x03, 0x12, 0xad, 0x6f, 0xb0, 0x63, 0xdf, 0x61, 0xaf, 0xd0,
+	0xcb, 0xde, 0xa0, 0xc7, 0x6a, 0xa7, 0x9d, 0xb6, 0x29, 0x79, 0x91, 0x09, 0x48, 0xbb, 0x0d, 0x21,
+	0xed, 0x62, 0xf9, 0xf3, 0xef, 0x33, 0xfa, 0x7f, 0x9f, 0x30, 0x68, 0xd3, 0x20, 0xf4, 0xe3, 0x6e,
+	0xbe, 0x2e, 0x8e, 0xba, 0xb3, 0x88, 0x2e, 0x48, 0x38, 0x09, 0xa7, 0x44, 0x9d, 0x45, 0x34, 0xa1,
+	0x70, 0x27, 0x43, 0x6a, 0xbe, 0x2e, 0x8e, 0xa4, 0xe6, 0x94, 0xc6, 0x01, 0x8d, 0xc7, 0x19, 0xed,
+	0xe6, 0x22, 0xb7, 0x4a, 0x7b, 0x1e, 0xf5, 0x68, 0x7e, 0x9e, 0xee, 0xd6, 0xa7, 0x6d, 0x8f, 0x52,
+	0xef, 0x03, 0xe9, 0x66, 0xea, 0x62, 0x7e, 0xd9, 0x4d, 0xfc, 0x80, 0xc4, 0xc9, 0x24, 0x98, 0xe5,
+	0x86, 0xe7, 0x5f, 0x2b, 0x60, 0x5b, 0x9f, 0xc7, 0x09, 0x75, 0xaf, 0x31, 0x99, 0xd2, 0xc8, 0x85,
+	0x0d, 0x50, 0xf3, 0x13, 0x12, 0x8c, 0x7d, 0x57, 0x64, 0x3b, 0xac, 0xc2, 0x61, 0x3e, 0x95, 0xa6,
+	0x0b, 0x25, 0x50, 0x8f, 0xc9, 0xc7, 0x39, 0x09, 0xa7, 0x44, 0xac, 0x64, 0xe4, 0x51, 0xc3, 0x97,
+	0x80, 0xbb, 0x8c, 0x68, 0x20, 0x56, 0x3b, 0xac, 0xb2, 0xd9, 0x13, 0xbf, 0xdd, 0x1e, 0xee, 0xad,
+	0xa7, 0xd3, 0x5c, 0x37, 0x22, 0x71, 0x6c, 0x27, 0x91, 0x1f, 0x7a, 0x38, 0x73, 0x41, 0x05, 0x54,
+	0x12, 0x2a, 0x72, 0xff, 0xf1, 0x56, 0x12, 0x0a, 0xf7, 0x01, 0x7f, 0x45, 0x7c, 0xef, 0x2a, 0x11,
+	0x37, 0x3a, 0xac, 0x52, 0xc5, 0x6b, 0x05, 0xdf, 0x00, 0x2e, 0x4d, 0x22, 0xf2, 0x1d, 0x56, 0xd9,
+	0x3a, 0x96, 0xd4, 0x3c, 0xa6, 0xfa, 0x10, 0x53, 0x75, 0x1e, 0x62, 0xf6, 0xea, 0x77, 0x3f, 0xda,
+	0xcc, 0xcd, 0xcf, 0x36, 0x8b, 0xb3, 0x1b, 0xf0, 0x35, 0xe0, 0x23, 0x32, 0x89, 0x69, 0x28, 0xd6,
+	0x3a, 0xac, 0xb2, 0x73, 0xdc, 0x52, 0xff, 0xed, 0x58, 0x7d, 0x6c, 0x23, 0x35, 0xe1, 0xb5, 0x19,
+	0x42, 0xc0, 0x05, 0x24, 0xa0, 0x62, 0x3d, 0x1d, 0x1a, 0x67, 0xfb, 0x17, 0xb7, 0xd5, 0xbf, 0xba,
+	0xcb, 0x5c, 0x32, 0x90, 0xf4, 0x91, 0xed, 0x0c, 0x8d, 0xb3, 0x31, 0x46, 0x9a, 0x3d, 0xb4, 0xc6,
+	0x23, 0xcb, 0x3e, 0x45, 0xba, 0xd9, 0x37, 0x91, 0x21, 0x30, 0x50, 0x02, 0xfb, 0x05, 0xae, 0x63,
+	0xa4, 0x39, 0xc8, 0x10, 0x58, 0x78, 0x00, 0x1a, 0x05, 0xe6, 0x60, 0xcd, 0xb2, 0xfb, 0x08, 0x0b,
+	0x15, 0xd8, 0x00, 0xbb, 0x05, 0x68, 0x6b, 0x03, 0x24, 0x54, 0xe1, 0x33, 0xd0, 0x2a, 0x80, 0x3e,
+	0xd6, 0x74, 0xc7, 0x1c, 0x5a, 0xda, 0xc0, 0x3c, 0x47, 0x86, 0xc0, 0x95, 0x7c, 0x18, 0x23, 0x03,
+	0xa1, 0x13, 0x64, 0x08, 0x1b, 0xb0, 0x09, 0x9e, 0x16, 0x60, 0x6f, 0x74, 0x36, 0x1c, 0x39, 0x02,
+	0x5f, 0x72, 0xcf, 0xec, 0xe9, 0x63, 0x1b, 0x59, 0x86, 0x50, 0x2b, 0x49, 0x9a, 0x42, 0x8c, 0x74,
+	0x64, 0xbe, 0x43, 0x42, 0x1d, 0xb6, 0x40, 0xb3, 0x94, 0xf7, 0x47, 0x96, 0x21, 0x6c, 0xc2, 0x36,
+	0x38, 0x28, 0x60, 0xab, 0xef, 0xfc, 0x09, 0x0c, 0x4a, 0x9a, 0x32, 0xd0, 0x00, 0xa5, 0x4d, 0x6d,
+	0x95, 0x30, 0xf4, 0xfe, 0xd4, 0xc4, 0xc8, 0x10, 0x9e, 0x94, 0x0c, 0x7d, 0x62, 0xbe, 0xc5, 0x59,
+	0xc5, 0xdb, 0x12, 0xf7, 0xf9, 0x8b, 0xcc, 0xf4, 0x0e, 0xef, 0x96, 0x32, 0x7b, 0xbf, 0x94, 0xd9,
+	0x5f, 0x4b, 0x99, 0xbd, 0x59, 0xc9, 0xcc, 0xfd, 0x4a, 0x66, 0xbe, 0xaf, 0x64, 0xe6, 0x7c, 0x37,
+	0x7f, 0x89, 0x9f, 0xd6, 0x2f, 0x32, 0xb9, 0x9e, 0x91, 0xf8, 0x82, 0xcf, 0x7e, 0xaa, 0x57, 0xbf,
+	0x03, 0x00, 0x00, 0xff, 0xff, 0x8a, 0x66, 0xde, 0x22, 0xad, 0x03, 0x00, 0x00,
+}
+
+func (m *CustodyRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CustodyRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CustodyRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintProvenance(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Reason != 0 {
+		i = encodeVarintProvenance(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x38
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintProvenance(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if m.Height != 0 {
+		i = encodeVarintProvenance(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintProvenance(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintProvenance(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintProvenance(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ItemId != 0 {
+		i = encodeVarintProvenance(dAtA, i, uint64(m.ItemId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProvenance(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProvenance(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CustodyRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ItemId != 0 {
+		n += 1 + sovProvenance(uint64(m.ItemId))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovProvenance(uint64(m.Sequence))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovProvenance(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovProvenance(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovProvenance(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovProvenance(uint64(l))
+	if m.Reason != 0 {
+		n += 1 + sovProvenance(uint64(m.Reason))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovProvenance(uint64(l))
+	}
+	return n
+}
+
+func sovProvenance(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProvenance(x uint64) (n int) {
+	return sovProvenance(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CustodyRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvenance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CustodyRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CustodyRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ItemId", wireType)
+			}
+			m.ItemId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvenance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ItemId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvenance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvenance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvenance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvenance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvenance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvenance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvenance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvenance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvenance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvenance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvenance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvenance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= CustodyReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvenance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvenance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvenance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvenance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvenance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProvenance(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProvenance
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProvenance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProvenance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProvenance
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProvenance
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProvenance
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProvenance        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProvenance          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProvenance = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,28 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxRoyaltyBasisPoints is the royalty taking the whole sale price.
+const MaxRoyaltyBasisPoints = 10_000
+
+// Validate checks that the royalty has a recipient and a positive share of at
+// most the whole sale price.
+func (r Royalty) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.Recipient); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid royalty recipient: %s", err)
+	}
+	if r.BasisPoints == 0 || r.BasisPoints > MaxRoyaltyBasisPoints {
+		return errorsmod.Wrapf(ErrInvalidRoyalty, "basis points must be between 1 and %d", MaxRoyaltyBasisPoints)
+	}
+	return nil
+}
+
+// RoyaltyOf returns the part of a sale price owed to the royalty recipient,
+// rounded down.
+func (r Royalty) RoyaltyOf(price sdk.Coin) sdk.Coin {
+	return sdk.NewCoin(price.Denom, price.Amount.MulRaw(int64(r.BasisPoints)).QuoRaw(MaxRoyaltyBasisPoints))
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
+
+	"omnis/x/token/types"
+)
+
+// EpochHooks wraps the keeper to implement the x/epochs hooks.
+type EpochHooks struct {
+	k Keeper
+}
+
+var _ epochstypes.EpochHooks = EpochHooks{}
+
+// EpochHooks returns the x/epochs hooks of the token module.
+func (k Keeper) EpochHooks() EpochHooks {
+	return EpochHooks{k}
+}
+
+// AfterEpochEnd resets the minted counter of every mint limit tracked against
+// the epoch that just ended.
+func (h EpochHooks) AfterEpochEnd(ctx context.Context, epochIdentifier string, _ int64) error {
+	var limits []types.MintLimit
+	err := h.k.MintLimit.Walk(ctx, nil, func(_ uint64, limit types.MintLimit) (bool, error) {
+		if limit.EpochIdentifier == epochIdentifier {
+			limits = append(limits, limit)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, limit := range limits {
+		limit.Minted = math.ZeroInt().String()
+		if err := h.k.MintLimit.Set(ctx, limit.TokenId, limit); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// BeforeEpochStart implements epochstypes.EpochHooks.
+func (h EpochHooks) BeforeEpochStart(_ context.Context, _ string, _ int64) error {
+	return nil
+}
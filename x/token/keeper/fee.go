@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"omnis/x/token/types"
+)
+
+// ConvertFeeToNative returns the native fee denom equivalent of a fee paid in
+// a whitelisted OMS-20 denom, together with the conversion rate used. It
+// returns ErrFeeTokenNotAllowed if the denom is not whitelisted.
+func (k Keeper) ConvertFeeToNative(ctx context.Context, fee sdk.Coin) (sdk.Coin, types.FeeToken, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return sdk.Coin{}, types.FeeToken{}, err
+	}
+
+	feeToken, found := params.FeeToken(fee.Denom)
+	if !found {
+		return sdk.Coin{}, types.FeeToken{}, errorsmod.Wrap(types.ErrFeeTokenNotAllowed, fee.Denom)
+	}
+
+	rate, err := feeToken.ConversionRateDec()
+	if err != nil {
+		return sdk.Coin{}, types.FeeToken{}, err
+	}
+
+	amount := rate.MulInt(fee.Amount).TruncateInt()
+	return sdk.NewCoin(params.NativeFeeDenom, amount), feeToken, nil
+}
+
+// IsFeeToken reports whether denom is whitelisted for fee payment.
+func (k Keeper) IsFeeToken(ctx context.Context, denom string) bool {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return false
+	}
+	_, found := params.FeeToken(denom)
+	return found
+}
+
+// PayFeeInToken swaps a fee paid in a whitelisted OMS-20 denom for the native
+// fee denom: the token fee is moved from the payer to the fee liquidity
+// account, which in turn credits the native equivalent from its own balance to
+// the recipient module (usually the fee collector). It returns the native fee.
+func (k Keeper) PayFeeInToken(ctx context.Context, payer sdk.AccAddress, fee sdk.Coin, recipientModule string) (sdk.Coin, error) {
+	nativeFee, feeToken, err := k.ConvertFeeToNative(ctx, fee)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if fee.IsZero() {
+		return nativeFee, nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, types.FeeLiquidityName, sdk.NewCoins(fee)); err != nil {
+		return sdk.Coin{}, errorsmod.Wrapf(err, "failed to collect fee of %s", fee)
+	}
+
+	if nativeFee.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.FeeLiquidityName, recipientModule, sdk.NewCoins(nativeFee)); err != nil {
+			return sdk.Coin{}, errorsmod.Wrapf(err, "insufficient %s liquidity to convert fee of %s", nativeFee.Denom, fee)
+		}
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeFeeAbstraction,
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+			sdk.NewAttribute(types.AttributeKeyEffectiveFee, nativeFee.String()),
+			sdk.NewAttribute(types.AttributeKeyConversionRate, feeToken.ConversionRate),
+			sdk.NewAttribute(types.AttributeKeyFeePayer, payer.String()),
+		),
+	)
+
+	return nativeFee, nil
+}
@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"omnis/x/token/keeper"
+	"omnis/x/token/types"
+)
+
+func TestPayFeeInToken(t *testing.T) {
+	f := initFixture(t)
+
+	payer := sdk.AccAddress("payer_______________")
+	liquidity := authtypes.NewModuleAddress(types.FeeLiquidityName).String()
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName).String()
+
+	params := types.NewParams([]types.FeeToken{{Denom: "oms", ConversionRate: "0.5"}}, "stake", nil)
+	require.NoError(t, params.Validate())
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	require.True(t, f.keeper.IsFeeToken(f.ctx, "oms"))
+	require.False(t, f.keeper.IsFeeToken(f.ctx, "other"))
+
+	_, err := f.keeper.PayFeeInToken(f.ctx, payer, sdk.NewInt64Coin("other", 10), authtypes.FeeCollectorName)
+	require.ErrorIs(t, err, types.ErrFeeTokenNotAllowed)
+
+	f.bankKeeper.balances[payer.String()] = sdk.NewCoins(sdk.NewInt64Coin("oms", 100))
+
+	// the fee liquidity account has not been funded yet
+	_, err = f.keeper.PayFeeInToken(f.ctx, payer, sdk.NewInt64Coin("oms", 40), authtypes.FeeCollectorName)
+	require.Error(t, err)
+
+	// the failed swap above would be reverted with the tx, reset the mock balances
+	f.bankKeeper.balances = map[string]sdk.Coins{
+		payer.String(): sdk.NewCoins(sdk.NewInt64Coin("oms", 100)),
+		liquidity:      sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
+	}
+	nativeFee, err := f.keeper.PayFeeInToken(f.ctx, payer, sdk.NewInt64Coin("oms", 40), authtypes.FeeCollectorName)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("stake", 20), nativeFee)
+	require.Equal(t, math.NewInt(20), f.bankKeeper.balances[feeCollector].AmountOf("stake"))
+	require.Equal(t, math.NewInt(40), f.bankKeeper.balances[liquidity].AmountOf("oms"))
+	require.Equal(t, math.NewInt(60), f.bankKeeper.balances[payer.String()].AmountOf("oms"))
+}
+
+func TestFeeLiquidity(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	authority := sdk.AccAddress(f.keeper.GetAuthority())
+	authorityStr, err := f.addressCodec.BytesToString(authority)
+	require.NoError(t, err)
+	recipient := sdk.AccAddress("recipient___________")
+	recipientStr, err := f.addressCodec.BytesToString(recipient)
+	require.NoError(t, err)
+	liquidity := authtypes.NewModuleAddress(types.FeeLiquidityName).String()
+
+	f.bankKeeper.balances[authority.String()] = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	_, err = srv.FundFeeLiquidity(f.ctx, &types.MsgFundFeeLiquidity{Authority: recipientStr, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 60))})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = srv.FundFeeLiquidity(f.ctx, &types.MsgFundFeeLiquidity{Authority: authorityStr})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidCoins)
+
+	_, err = srv.FundFeeLiquidity(f.ctx, &types.MsgFundFeeLiquidity{Authority: authorityStr, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 60))})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(60), f.bankKeeper.balances[liquidity].AmountOf("stake"))
+
+	_, err = srv.WithdrawFeeLiquidity(f.ctx, &types.MsgWithdrawFeeLiquidity{Authority: recipientStr, Recipient: recipientStr, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = srv.WithdrawFeeLiquidity(f.ctx, &types.MsgWithdrawFeeLiquidity{Authority: authorityStr, Recipient: recipientStr, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 61))})
+	require.Error(t, err)
+
+	_, err = srv.WithdrawFeeLiquidity(f.ctx, &types.MsgWithdrawFeeLiquidity{Authority: authorityStr, Recipient: recipientStr, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(50), f.bankKeeper.balances[liquidity].AmountOf("stake"))
+	require.Equal(t, math.NewInt(10), f.bankKeeper.balances[recipient.String()].AmountOf("stake"))
+}
@@ -14,6 +14,18 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 		}
 	}
 
+	for _, elem := range genState.MintLimitList {
+		if err := k.MintLimit.Set(ctx, elem.TokenId, elem); err != nil {
+			return err
+		}
+	}
+
+	for _, elem := range genState.WrappedTokenList {
+		if err := k.Wrapped.Set(ctx, elem.Denom, elem.TokenId); err != nil {
+			return err
+		}
+	}
+
 	if err := k.TokenSeq.Set(ctx, genState.TokenCount); err != nil {
 		return err
 	}
@@ -37,6 +49,22 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	err = k.MintLimit.Walk(ctx, nil, func(_ uint64, elem types.MintLimit) (bool, error) {
+		genesis.MintLimitList = append(genesis.MintLimitList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Wrapped.Walk(ctx, nil, func(denom string, tokenID uint64) (bool, error) {
+		genesis.WrappedTokenList = append(genesis.WrappedTokenList, types.WrappedToken{Denom: denom, TokenId: tokenID})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	genesis.TokenCount, err = k.TokenSeq.Peek(ctx)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"omnis/x/token/keeper"
+	"omnis/x/token/types"
+)
+
+// recordingHooks records every hook call and can veto burns.
+type recordingHooks struct {
+	calls     []string
+	blockBurn bool
+}
+
+var _ types.TokenHooks = &recordingHooks{}
+
+func (h *recordingHooks) AfterTokenCreated(_ context.Context, _ types.Token) error {
+	h.calls = append(h.calls, "AfterTokenCreated")
+	return nil
+}
+
+func (h *recordingHooks) AfterMint(_ context.Context, _ uint64, _ sdk.AccAddress, _ math.Int) error {
+	h.calls = append(h.calls, "AfterMint")
+	return nil
+}
+
+func (h *recordingHooks) BeforeBurn(_ context.Context, _ uint64, _ sdk.AccAddress, _ math.Int) error {
+	h.calls = append(h.calls, "BeforeBurn")
+	if h.blockBurn {
+		return errors.New("burn blocked")
+	}
+	return nil
+}
+
+func (h *recordingHooks) AfterTokenDeleted(_ context.Context, _ uint64) error {
+	h.calls = append(h.calls, "AfterTokenDeleted")
+	return nil
+}
+
+func TestTokenHooks(t *testing.T) {
+	f := initFixture(t)
+
+	first, second := &recordingHooks{}, &recordingHooks{}
+	f.keeper.SetHooks(types.NewMultiTokenHooks(first, second))
+	require.Panics(t, func() { f.keeper.SetHooks(first) })
+
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	resp, err := srv.CreateToken(f.ctx, &types.MsgCreateToken{Creator: creator, Name: "Omnis", Symbol: "oms", Decimals: "6", TotalSupply: "100"})
+	require.NoError(t, err)
+
+	_, err = srv.MintToken(f.ctx, &types.MsgMintToken{Creator: creator, Id: resp.Id, Amount: "10"})
+	require.NoError(t, err)
+
+	_, err = srv.BurnToken(f.ctx, &types.MsgBurnToken{Creator: creator, Id: resp.Id, Amount: "30"})
+	require.NoError(t, err)
+
+	token, err := f.keeper.Token.Get(f.ctx, resp.Id)
+	require.NoError(t, err)
+	require.Equal(t, "80", token.TotalSupply)
+
+	second.blockBurn = true
+	_, err = srv.BurnToken(f.ctx, &types.MsgBurnToken{Creator: creator, Id: resp.Id, Amount: "1"})
+	require.ErrorContains(t, err, "burn blocked")
+
+	_, err = srv.DeleteToken(f.ctx, &types.MsgDeleteToken{Creator: creator, Id: resp.Id})
+	require.NoError(t, err)
+
+	expected := []string{"AfterTokenCreated", "AfterMint", "BeforeBurn", "BeforeBurn", "AfterTokenDeleted"}
+	require.Equal(t, expected, first.calls)
+	require.Equal(t, expected, second.calls)
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"omnis/x/token/types"
+)
+
+// RegisterInvariants registers all token module invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "wrap-escrow", WrapEscrowInvariant(k))
+}
+
+// WrapEscrowInvariant checks that, for every wrapped denom, the escrowed
+// balance equals the bank supply and the registry supply of its wrapped token.
+func WrapEscrowInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		escrowAddr := authtypes.NewModuleAddress(types.WrapEscrowName)
+		err := k.Wrapped.Walk(ctx, nil, func(denom string, tokenID uint64) (bool, error) {
+			token, err := k.Token.Get(ctx, tokenID)
+			if err != nil {
+				return true, err
+			}
+
+			escrowed := k.bankKeeper.GetBalance(ctx, escrowAddr, denom).Amount
+			supply := k.bankKeeper.GetSupply(ctx, token.Symbol).Amount
+			if !escrowed.Equal(supply) || supply.String() != token.TotalSupply {
+				broken = true
+				msg += fmt.Sprintf("\t%s escrowed: %s, %s supply: %s, registry supply: %s\n",
+					denom, escrowed, token.Symbol, supply, token.TotalSupply)
+			}
+			return false, nil
+		})
+		if err != nil {
+			broken = true
+			msg += fmt.Sprintf("\tfailed to walk wrapped tokens: %s\n", err)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "wrap escrow",
+			fmt.Sprintf("escrow does not match the wrapped supply:\n%s", msg)), broken
+	}
+}
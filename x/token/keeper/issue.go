@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"omnis/x/token/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// IssueToken registers a token on behalf of the issuer module and mints its
+// whole supply to recipient. The token is created by the account of the issuer
+// module, so that no account can mint, update, delete or burn it directly.
+func (k Keeper) IssueToken(ctx context.Context, issuer string, token types.Token, recipient sdk.AccAddress) (types.Token, error) {
+	creator, err := k.addressCodec.BytesToString(k.authKeeper.GetModuleAccount(ctx, issuer).GetAddress())
+	if err != nil {
+		return types.Token{}, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+	token.Creator = creator
+	if err := sdk.ValidateDenom(token.Symbol); err != nil {
+		return types.Token{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid token symbol for denom: %s", token.Symbol)
+	}
+	supply, ok := math.NewIntFromString(token.TotalSupply)
+	if !ok || !supply.IsPositive() {
+		return types.Token{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid total supply: %s", token.TotalSupply)
+	}
+
+	if _, found := k.GetTokenBySymbol(ctx, token.Symbol); found {
+		return types.Token{}, errorsmod.Wrapf(types.ErrTokenAlreadyExists, "token with symbol %s already exists", token.Symbol)
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.Token{}, err
+	}
+	if _, reserved := params.WrappableDenomBySymbol(token.Symbol); reserved {
+		return types.Token{}, errorsmod.Wrapf(types.ErrTokenAlreadyExists, "symbol %s is reserved for a wrapped token", token.Symbol)
+	}
+	if err := k.checkDenomUnused(ctx, token.Symbol); err != nil {
+		return types.Token{}, err
+	}
+
+	token.Id, err = k.TokenSeq.Next(ctx)
+	if err != nil {
+		return types.Token{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "failed to get next id")
+	}
+	if err := k.Token.Set(ctx, token.Id, token); err != nil {
+		return types.Token{}, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set token")
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Description: token.Metadata,
+		DenomUnits:  []*banktypes.DenomUnit{{Denom: token.Symbol, Exponent: 0}},
+		Base:        token.Symbol,
+		Display:     token.Symbol,
+		Name:        token.Name,
+		Symbol:      token.Symbol,
+	})
+
+	coins := sdk.NewCoins(sdk.NewCoin(token.Symbol, supply))
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return types.Token{}, errorsmod.Wrapf(sdkerrors.ErrLogic, "failed to mint coins: %v", err)
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coins); err != nil {
+		return types.Token{}, errorsmod.Wrapf(sdkerrors.ErrLogic, "failed to send minted coins to recipient: %v", err)
+	}
+
+	if err := k.Hooks().AfterTokenCreated(ctx, token); err != nil {
+		return types.Token{}, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeCreateToken,
+			sdk.NewAttribute(types.AttributeKeyTokenID, strconv.FormatUint(token.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyTokenName, token.Name),
+			sdk.NewAttribute(types.AttributeKeyTokenSymbol, token.Symbol),
+			sdk.NewAttribute(types.AttributeKeyCreator, token.Creator),
+			sdk.NewAttribute(types.AttributeKeyTotalSupply, token.TotalSupply),
+		),
+	)
+
+	return token, nil
+}
+
+// BurnTokenFrom burns an amount of a token held by holder on behalf of another
+// module, which is responsible for the authorization of the burn.
+func (k Keeper) BurnTokenFrom(ctx context.Context, tokenID uint64, holder sdk.AccAddress, amount math.Int) error {
+	if !amount.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid burn amount: %s", amount)
+	}
+
+	token, err := k.Token.Get(ctx, tokenID)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", tokenID))
+		}
+
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get token")
+	}
+
+	if err := k.Hooks().BeforeBurn(ctx, tokenID, holder, amount); err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(token.Symbol, amount))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, holder, types.ModuleName, coins); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "failed to burn coins: %v", err)
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrLogic, "failed to burn coins: %v", err)
+	}
+
+	if err := k.addTotalSupply(ctx, &token, amount.Neg()); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeBurnToken,
+			sdk.NewAttribute(types.AttributeKeyTokenID, strconv.FormatUint(tokenID, 10)),
+			sdk.NewAttribute(types.AttributeKeyCreator, holder.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyTotalSupply, token.TotalSupply),
+		),
+	)
+
+	return nil
+}
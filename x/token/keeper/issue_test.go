@@ -0,0 +1,62 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"omnis/x/token/keeper"
+	"omnis/x/token/types"
+)
+
+func TestIssueToken(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	holder, err := f.addressCodec.BytesToString([]byte("holderAddr__________________"))
+	require.NoError(t, err)
+	holderAddr := sdk.MustAccAddressFromBech32(holder)
+	issuer, err := f.addressCodec.BytesToString(authtypes.NewModuleAddress("issuer"))
+	require.NoError(t, err)
+
+	newToken := func(symbol, supply string) types.Token {
+		return types.Token{Name: "Fraction", Symbol: symbol, TotalSupply: supply}
+	}
+	_, err = f.keeper.IssueToken(f.ctx, "issuer", newToken("frac", "0"), holderAddr)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = f.keeper.IssueToken(f.ctx, "issuer", newToken("1frac", "100"), holderAddr)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// Denoms already in use, such as the native denom, cannot be issued
+	f.bankKeeper.balances[holder] = sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	_, err = f.keeper.IssueToken(f.ctx, "issuer", newToken("stake", "100"), holderAddr)
+	require.ErrorIs(t, err, types.ErrTokenAlreadyExists)
+	require.Equal(t, math.NewInt(10), f.bankKeeper.balances[holder].AmountOf("stake"))
+
+	token, err := f.keeper.IssueToken(f.ctx, "issuer", newToken("frac", "100"), holderAddr)
+	require.NoError(t, err)
+	require.Equal(t, issuer, token.Creator)
+	require.Equal(t, math.NewInt(100), f.bankKeeper.balances[holder].AmountOf("frac"))
+	_, err = f.keeper.IssueToken(f.ctx, "issuer", newToken("frac", "100"), holderAddr)
+	require.ErrorIs(t, err, types.ErrTokenAlreadyExists)
+
+	// Nobody may mint an issued token
+	_, err = srv.MintToken(f.ctx, &types.MsgMintToken{Creator: holder, Id: token.Id, Amount: "1"})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// Nor burn it outside of the issuer module, which tracks its supply
+	_, err = srv.BurnToken(f.ctx, &types.MsgBurnToken{Creator: holder, Id: token.Id, Amount: "1"})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.Equal(t, math.NewInt(100), f.bankKeeper.balances[holder].AmountOf("frac"))
+
+	require.ErrorIs(t, f.keeper.BurnTokenFrom(f.ctx, token.Id, holderAddr, math.NewInt(101)), sdkerrors.ErrInsufficientFunds)
+	require.NoError(t, f.keeper.BurnTokenFrom(f.ctx, token.Id, holderAddr, math.NewInt(40)))
+	require.Equal(t, math.NewInt(60), f.bankKeeper.balances[holder].AmountOf("frac"))
+	stored, err := f.keeper.Token.Get(f.ctx, token.Id)
+	require.NoError(t, err)
+	require.Equal(t, "60", stored.TotalSupply)
+}
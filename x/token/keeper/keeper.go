@@ -1,58 +1,122 @@
-
 package keeper
 
 import (
-	"context" // New: Needed for collections interactions
+	"context"
 	"fmt"
 
-	"omnis/x/token/types"
-
-	"cosmossdk.io/collections" // Keep collections
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
-	"cosmossdk.io/log" // Use cosmossdk.io/log instead of tendermint/tendermint/libs/log
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
-	sdk "github.com/cosmos/cosmos-sdk/types" // New: Needed for bankKeeper
-	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types" // New: Needed for accountKeeper if not already there
-	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper" // New: Import bankkeeper
+	"omnis/x/token/types"
 )
 
-type (
-	Keeper struct {
-		// Keep the collections that Ignite scaffolded
-		Token    collections.Map[uint64, types.Token]
-		TokenSeq collections.Sequence
-		Schema   collections.Schema
+type Keeper struct {
+	storeService corestore.KVStoreService
+	cdc          codec.Codec
+	addressCodec address.Codec
+	// Address capable of executing a MsgUpdateParams message.
+	// Typically, this should be the x/gov module account.
+	authority []byte
 
-		addressCodec address.Codec
-		bankKeeper   bankkeeper.Keeper       // New: Add bankKeeper
-		accountKeeper types.AccountKeeper    // New: Add accountKeeper (use types.AccountKeeper to match interface)
-	}
-)
+	authKeeper types.AuthKeeper
+	bankKeeper types.BankKeeper
+	hooks      types.TokenHooks
+
+	Schema    collections.Schema
+	Params    collections.Item[types.Params]
+	Token     collections.Map[uint64, types.Token]
+	TokenSeq  collections.Sequence
+	MintLimit collections.Map[uint64, types.MintLimit]
+	// Wrapped maps a wrapped native or IBC denom to its registry token id.
+	Wrapped collections.Map[string, uint64]
+}
 
 func NewKeeper(
+	storeService corestore.KVStoreService,
+	cdc codec.Codec,
 	addressCodec address.Codec,
-	storeService sdk.StoreService, // Use StoreService from SDK context
-	bankKeeper bankkeeper.Keeper,     // New: Pass bankKeeper
-	accountKeeper types.AccountKeeper, // New: Pass accountKeeper
+	authority []byte,
+	authKeeper types.AuthKeeper,
+	bankKeeper types.BankKeeper,
 ) Keeper {
+	if _, err := addressCodec.BytesToString(authority); err != nil {
+		panic(fmt.Sprintf("invalid authority address %s: %s", authority, err))
+	}
+
 	sb := collections.NewSchemaBuilder(storeService)
+
 	k := Keeper{
+		storeService: storeService,
+		cdc:          cdc,
 		addressCodec: addressCodec,
-		bankKeeper:   bankKeeper,      // Assign bankKeeper
-		accountKeeper: accountKeeper, // Assign accountKeeper
-		TokenSeq: collections.NewSequence(sb, types.TokenKeyPrefix+"_seq"),
-		Token: collections.NewMap(sb, types.TokenKeyPrefix, collections.Uint64Key, sdk.NewProtoValue(types.Token{})),
+		authority:    authority,
+		authKeeper:   authKeeper,
+		bankKeeper:   bankKeeper,
+
+		Params:    collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Token:     collections.NewMap(sb, types.TokenKey, "token", collections.Uint64Key, codec.CollValue[types.Token](cdc)),
+		TokenSeq:  collections.NewSequence(sb, types.TokenCountKey, "tokenSequence"),
+		MintLimit: collections.NewMap(sb, types.MintLimitKey, "mint_limit", collections.Uint64Key, codec.CollValue[types.MintLimit](cdc)),
+		Wrapped:   collections.NewMap(sb, types.WrappedKey, "wrapped", collections.StringKey, collections.Uint64Value),
 	}
+
 	schema, err := sb.Build()
 	if err != nil {
 		panic(err)
 	}
 	k.Schema = schema
+
 	return k
 }
 
-func (k Keeper) Logger(ctx context.Context) log.Logger { // Use context.Context for collections
-	sdkCtx := sdk.UnwrapSDKContext(ctx) // Unwrap to sdk.Context for logger if needed
+// SetHooks sets the token hooks. In contrast to other receivers, this method
+// must take a pointer due to nature of the hooks interface and SDK start up
+// sequence.
+func (k *Keeper) SetHooks(th types.TokenHooks) {
+	if k.hooks != nil {
+		panic("cannot set token hooks twice")
+	}
+
+	k.hooks = th
+}
+
+// Hooks returns the token hooks, or a no-op implementation if none are set.
+func (k Keeper) Hooks() types.TokenHooks {
+	if k.hooks == nil {
+		return types.MultiTokenHooks{}
+	}
+	return k.hooks
+}
+
+// ModuleAddress returns the address of the token module account, which is
+// recorded as the creator of wrapped tokens.
+func (k Keeper) ModuleAddress() (string, error) {
+	return k.addressCodec.BytesToString(authtypes.NewModuleAddress(types.ModuleName))
+}
+
+// isModuleAccount reports whether address is the account of a module, such
+// as the creator of the tokens issued through IssueToken.
+func (k Keeper) isModuleAccount(ctx context.Context, address string) bool {
+	addr, err := k.addressCodec.StringToBytes(address)
+	if err != nil {
+		return false
+	}
+	_, ok := k.authKeeper.GetAccount(ctx, addr).(sdk.ModuleAccountI)
+	return ok
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() []byte {
+	return k.authority
+}
+
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	return sdkCtx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
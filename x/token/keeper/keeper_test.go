@@ -2,9 +2,11 @@ package keeper_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/runtime"
@@ -12,6 +14,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"omnis/x/token/keeper"
 	module "omnis/x/token/module"
@@ -22,6 +25,111 @@ type fixture struct {
 	ctx          context.Context
 	keeper       keeper.Keeper
 	addressCodec address.Codec
+	authKeeper   *mockAuthKeeper
+	bankKeeper   *mockBankKeeper
+}
+
+// mockAuthKeeper records the module accounts created through it, which is all
+// the token keeper needs from the auth keeper.
+type mockAuthKeeper struct {
+	addressCodec address.Codec
+	accounts     map[string]sdk.AccountI
+}
+
+func newMockAuthKeeper(addressCodec address.Codec) *mockAuthKeeper {
+	return &mockAuthKeeper{addressCodec: addressCodec, accounts: make(map[string]sdk.AccountI)}
+}
+
+func (m *mockAuthKeeper) AddressCodec() address.Codec {
+	return m.addressCodec
+}
+
+func (m *mockAuthKeeper) GetAccount(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
+	return m.accounts[addr.String()]
+}
+
+func (m *mockAuthKeeper) GetModuleAccount(_ context.Context, moduleName string) sdk.ModuleAccountI {
+	account := authtypes.NewEmptyModuleAccount(moduleName)
+	m.accounts[account.GetAddress().String()] = account
+	return account
+}
+
+// mockBankKeeper records balances in memory so that msg server tests can run
+// without a full bank keeper.
+type mockBankKeeper struct {
+	balances map[string]sdk.Coins
+}
+
+func newMockBankKeeper() *mockBankKeeper {
+	return &mockBankKeeper{balances: make(map[string]sdk.Coins)}
+}
+
+func (m *mockBankKeeper) SpendableCoins(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return m.balances[addr.String()]
+}
+
+func (m *mockBankKeeper) HasSupply(_ context.Context, denom string) bool {
+	for _, balance := range m.balances {
+		if balance.AmountOf(denom).IsPositive() {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *mockBankKeeper) HasDenomMetaData(context.Context, string) bool {
+	return false
+}
+func (m *mockBankKeeper) GetBalance(_ context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, m.balances[addr.String()].AmountOf(denom))
+}
+
+func (m *mockBankKeeper) GetSupply(_ context.Context, denom string) sdk.Coin {
+	supply := sdk.NewCoin(denom, math.ZeroInt())
+	for _, balance := range m.balances {
+		supply = supply.AddAmount(balance.AmountOf(denom))
+	}
+	return supply
+}
+
+func (m *mockBankKeeper) SetDenomMetaData(context.Context, banktypes.Metadata) {}
+
+func (m *mockBankKeeper) MintCoins(_ context.Context, moduleName string, amt sdk.Coins) error {
+	addr := authtypes.NewModuleAddress(moduleName).String()
+	m.balances[addr] = m.balances[addr].Add(amt...)
+	return nil
+}
+
+func (m *mockBankKeeper) BurnCoins(_ context.Context, moduleName string, amt sdk.Coins) error {
+	addr := authtypes.NewModuleAddress(moduleName).String()
+	balance, negative := m.balances[addr].SafeSub(amt...)
+	if negative {
+		return fmt.Errorf("insufficient funds in module %s", moduleName)
+	}
+	m.balances[addr] = balance
+	return nil
+}
+
+func (m *mockBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return m.send(authtypes.NewModuleAddress(senderModule).String(), recipientAddr.String(), amt)
+}
+
+func (m *mockBankKeeper) SendCoinsFromAccountToModule(_ context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return m.send(senderAddr.String(), authtypes.NewModuleAddress(recipientModule).String(), amt)
+}
+
+func (m *mockBankKeeper) SendCoinsFromModuleToModule(_ context.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	return m.send(authtypes.NewModuleAddress(senderModule).String(), authtypes.NewModuleAddress(recipientModule).String(), amt)
+}
+
+func (m *mockBankKeeper) send(from, to string, amt sdk.Coins) error {
+	balance, negative := m.balances[from].SafeSub(amt...)
+	if negative {
+		return fmt.Errorf("insufficient funds in %s", from)
+	}
+	m.balances[from] = balance
+	m.balances[to] = m.balances[to].Add(amt...)
+	return nil
 }
 
 func initFixture(t *testing.T) *fixture {
@@ -35,12 +143,16 @@ func initFixture(t *testing.T) *fixture {
 	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
 
 	authority := authtypes.NewModuleAddress(types.GovModuleName)
+	authKeeper := newMockAuthKeeper(addressCodec)
+	bankKeeper := newMockBankKeeper()
 
 	k := keeper.NewKeeper(
 		storeService,
 		encCfg.Codec,
 		addressCodec,
 		authority,
+		authKeeper,
+		bankKeeper,
 	)
 
 	// Initialize params
@@ -52,5 +164,7 @@ func initFixture(t *testing.T) *fixture {
 		ctx:          ctx,
 		keeper:       k,
 		addressCodec: addressCodec,
+		authKeeper:   authKeeper,
+		bankKeeper:   bankKeeper,
 	}
 }
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"omnis/x/token/types"
+)
+
+// FundFeeLiquidity moves coins from the module authority to the fee liquidity
+// account, from which fees paid in OMS-20 tokens are converted.
+func (k msgServer) FundFeeLiquidity(ctx context.Context, msg *types.MsgFundFeeLiquidity) (*types.MsgFundFeeLiquidityResponse, error) {
+	authority, err := k.checkAuthority(msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount: %s", msg.Amount)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, authority, types.FeeLiquidityName, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeFundFeeLiquidity,
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+	)
+
+	return &types.MsgFundFeeLiquidityResponse{}, nil
+}
+
+// WithdrawFeeLiquidity moves native liquidity or collected OMS-20 fees from the
+// fee liquidity account to a recipient chosen by the module authority.
+func (k msgServer) WithdrawFeeLiquidity(ctx context.Context, msg *types.MsgWithdrawFeeLiquidity) (*types.MsgWithdrawFeeLiquidityResponse, error) {
+	if _, err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	recipient, err := k.addressCodec.StringToBytes(msg.Recipient)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount: %s", msg.Amount)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.FeeLiquidityName, recipient, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeWithdrawFeeLiquidity,
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+	)
+
+	return &types.MsgWithdrawFeeLiquidityResponse{}, nil
+}
+
+// checkAuthority returns the address of signer if it is the module authority.
+func (k msgServer) checkAuthority(signer string) (sdk.AccAddress, error) {
+	authority, err := k.addressCodec.StringToBytes(signer)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if !bytes.Equal(k.GetAuthority(), authority) {
+		expectedAuthorityStr, _ := k.addressCodec.BytesToString(k.GetAuthority())
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", expectedAuthorityStr, signer)
+	}
+
+	return authority, nil
+}
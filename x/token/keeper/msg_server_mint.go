@@ -0,0 +1,266 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"omnis/x/token/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) MintToken(goCtx context.Context, msg *types.MsgMintToken) (*types.MsgMintTokenResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	recipient := msg.Recipient
+	if recipient == "" {
+		recipient = msg.Creator
+	}
+	recipientAddr, err := k.addressCodec.StringToBytes(recipient)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid recipient address: %s", err))
+	}
+
+	amount, ok := math.NewIntFromString(msg.Amount)
+	if !ok || !amount.IsPositive() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid mint amount: %s", msg.Amount)
+	}
+
+	token, err := k.Token.Get(ctx, msg.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.Id))
+		}
+
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get token")
+	}
+
+	// Only the token admin may mint new supply
+	if msg.Creator != token.Creator {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	}
+
+	if err := k.consumeMintLimit(ctx, msg.Id, amount); err != nil {
+		return nil, err
+	}
+
+	totalSupply := math.ZeroInt()
+	if token.TotalSupply != "" {
+		totalSupply, ok = math.NewIntFromString(token.TotalSupply)
+		if !ok {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrLogic, "invalid stored total supply: %s", token.TotalSupply)
+		}
+	}
+	token.TotalSupply = totalSupply.Add(amount).String()
+
+	if err := k.Token.Set(ctx, msg.Id, token); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update token")
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(token.Symbol, amount))
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrLogic, "failed to mint coins: %v", err)
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipientAddr, coins); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrLogic, "failed to send minted coins to recipient: %v", err)
+	}
+
+	if err := k.Hooks().AfterMint(ctx, msg.Id, recipientAddr, amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeMintToken,
+			sdk.NewAttribute(types.AttributeKeyTokenID, strconv.FormatUint(msg.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyTotalSupply, token.TotalSupply),
+		),
+	)
+
+	return &types.MsgMintTokenResponse{}, nil
+}
+
+func (k msgServer) BurnToken(goCtx context.Context, msg *types.MsgBurnToken) (*types.MsgBurnTokenResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	burnerAddr, err := k.addressCodec.StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	amount, ok := math.NewIntFromString(msg.Amount)
+	if !ok || !amount.IsPositive() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid burn amount: %s", msg.Amount)
+	}
+
+	token, err := k.Token.Get(ctx, msg.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.Id))
+		}
+
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get token")
+	}
+
+	// Wrapped tokens can only be burnt through MsgUnwrap, which releases the escrow
+	if moduleAddr, err := k.ModuleAddress(); err != nil {
+		return nil, err
+	} else if token.Creator == moduleAddr {
+		return nil, errorsmod.Wrap(types.ErrWrappedToken, "use MsgUnwrap to burn a wrapped token")
+	}
+	// Issued tokens can only be burnt through their issuing module, which keeps
+	// track of their supply
+	if k.isModuleAccount(ctx, token.Creator) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "token %d was issued by a module and cannot be burnt directly", msg.Id)
+	}
+
+	if err := k.Hooks().BeforeBurn(ctx, msg.Id, burnerAddr, amount); err != nil {
+		return nil, err
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(token.Symbol, amount))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, burnerAddr, types.ModuleName, coins); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "failed to burn coins: %v", err)
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrLogic, "failed to burn coins: %v", err)
+	}
+
+	totalSupply, ok := math.NewIntFromString(token.TotalSupply)
+	if !ok || totalSupply.LT(amount) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrLogic, "invalid stored total supply: %s", token.TotalSupply)
+	}
+	token.TotalSupply = totalSupply.Sub(amount).String()
+
+	if err := k.Token.Set(ctx, msg.Id, token); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update token")
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeBurnToken,
+			sdk.NewAttribute(types.AttributeKeyTokenID, strconv.FormatUint(msg.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyTotalSupply, token.TotalSupply),
+		),
+	)
+
+	return &types.MsgBurnTokenResponse{}, nil
+}
+
+// SetMintLimit sets, tightens or removes the per-epoch mint limit of a token.
+// The token admin may install a limit or lower an existing one; raising or
+// removing an existing limit requires the module authority, so that a
+// compromised admin key cannot lift the bound it is subject to.
+func (k msgServer) SetMintLimit(ctx context.Context, msg *types.MsgSetMintLimit) (*types.MsgSetMintLimitResponse, error) {
+	signer, err := k.addressCodec.StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+	isAuthority := bytes.Equal(signer, k.GetAuthority())
+
+	token, err := k.Token.Get(ctx, msg.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.Id))
+		}
+
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get token")
+	}
+
+	if msg.Creator != token.Creator && !isAuthority {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	}
+
+	current, err := k.MintLimit.Get(ctx, msg.Id)
+	hasLimit := err == nil
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get mint limit")
+	}
+
+	if msg.EpochIdentifier == "" {
+		if hasLimit && !isAuthority {
+			return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the module authority can remove a mint limit")
+		}
+		if err := k.MintLimit.Remove(ctx, msg.Id); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to remove mint limit")
+		}
+	} else {
+		maxAmount, ok := math.NewIntFromString(msg.MaxAmount)
+		if !ok || maxAmount.IsNegative() {
+			return nil, errorsmod.Wrapf(types.ErrInvalidMintLimit, "invalid max amount: %s", msg.MaxAmount)
+		}
+
+		limit := types.NewMintLimit(msg.Id, msg.EpochIdentifier, maxAmount)
+		if hasLimit {
+			currentMax, err := current.MaxAmountInt()
+			if err != nil {
+				return nil, err
+			}
+			if !isAuthority && (maxAmount.GT(currentMax) || msg.EpochIdentifier != current.EpochIdentifier) {
+				return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the module authority can raise a mint limit or change its epoch")
+			}
+			// Keep the counter so that re-setting a limit does not reset the current epoch.
+			limit.Minted = current.Minted
+		}
+
+		if err := k.MintLimit.Set(ctx, msg.Id, limit); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set mint limit")
+		}
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeSetMintLimit,
+			sdk.NewAttribute(types.AttributeKeyTokenID, strconv.FormatUint(msg.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyEpochIdentifier, msg.EpochIdentifier),
+			sdk.NewAttribute(types.AttributeKeyMaxAmount, msg.MaxAmount),
+		),
+	)
+
+	return &types.MsgSetMintLimitResponse{}, nil
+}
+
+// consumeMintLimit adds amount to the minted counter of the token's current
+// epoch, failing if the configured maximum would be exceeded. Tokens without a
+// limit are not restricted.
+func (k Keeper) consumeMintLimit(ctx context.Context, tokenID uint64, amount math.Int) error {
+	limit, err := k.MintLimit.Get(ctx, tokenID)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get mint limit")
+	}
+
+	maxAmount, err := limit.MaxAmountInt()
+	if err != nil {
+		return err
+	}
+	minted, err := limit.MintedAmount()
+	if err != nil {
+		return err
+	}
+
+	minted = minted.Add(amount)
+	if minted.GT(maxAmount) {
+		return errorsmod.Wrapf(types.ErrMintLimitExceeded, "minting %s would bring the %s epoch total to %s, limit is %s",
+			amount, limit.EpochIdentifier, minted, maxAmount)
+	}
+
+	limit.Minted = minted.String()
+	return k.MintLimit.Set(ctx, tokenID, limit)
+}
@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"omnis/x/token/keeper"
+	"omnis/x/token/types"
+)
+
+func TestTokenMsgServerMint(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	unauthorizedAddr, err := f.addressCodec.BytesToString([]byte("unauthorizedAddr___________"))
+	require.NoError(t, err)
+
+	require.NoError(t, f.keeper.Token.Set(f.ctx, 0, types.Token{Id: 0, Symbol: "oms", TotalSupply: "100", Creator: creator}))
+
+	tests := []struct {
+		desc    string
+		request *types.MsgMintToken
+		err     error
+	}{
+		{
+			desc:    "invalid address",
+			request: &types.MsgMintToken{Creator: "invalid", Amount: "1"},
+			err:     sdkerrors.ErrInvalidAddress,
+		},
+		{
+			desc:    "invalid amount",
+			request: &types.MsgMintToken{Creator: creator, Amount: "0"},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "unauthorized",
+			request: &types.MsgMintToken{Creator: unauthorizedAddr, Amount: "1"},
+			err:     sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc:    "key not found",
+			request: &types.MsgMintToken{Creator: creator, Id: 10, Amount: "1"},
+			err:     sdkerrors.ErrKeyNotFound,
+		},
+		{
+			desc:    "completed",
+			request: &types.MsgMintToken{Creator: creator, Amount: "50"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err = srv.MintToken(f.ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	token, err := f.keeper.Token.Get(f.ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, "150", token.TotalSupply)
+	require.Equal(t, "50", f.bankKeeper.balances[creator].AmountOf("oms").String())
+}
+
+func TestTokenMsgServerMintLimit(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	require.NoError(t, f.keeper.Token.Set(f.ctx, 0, types.Token{Id: 0, Symbol: "oms", Creator: creator}))
+
+	_, err = srv.SetMintLimit(f.ctx, &types.MsgSetMintLimit{Creator: creator, Id: 0, EpochIdentifier: "day", MaxAmount: "100"})
+	require.NoError(t, err)
+
+	_, err = srv.MintToken(f.ctx, &types.MsgMintToken{Creator: creator, Id: 0, Amount: "60"})
+	require.NoError(t, err)
+
+	_, err = srv.MintToken(f.ctx, &types.MsgMintToken{Creator: creator, Id: 0, Amount: "41"})
+	require.ErrorIs(t, err, types.ErrMintLimitExceeded)
+
+	// the admin cannot lift its own limit
+	_, err = srv.SetMintLimit(f.ctx, &types.MsgSetMintLimit{Creator: creator, Id: 0, EpochIdentifier: "day", MaxAmount: "1000"})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.SetMintLimit(f.ctx, &types.MsgSetMintLimit{Creator: creator, Id: 0})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// ending an unrelated epoch does not reset the counter
+	require.NoError(t, f.keeper.EpochHooks().AfterEpochEnd(f.ctx, "week", 1))
+	_, err = srv.MintToken(f.ctx, &types.MsgMintToken{Creator: creator, Id: 0, Amount: "41"})
+	require.ErrorIs(t, err, types.ErrMintLimitExceeded)
+
+	require.NoError(t, f.keeper.EpochHooks().AfterEpochEnd(f.ctx, "day", 1))
+	_, err = srv.MintToken(f.ctx, &types.MsgMintToken{Creator: creator, Id: 0, Amount: "100"})
+	require.NoError(t, err)
+
+	// the authority can remove the limit
+	_, err = srv.SetMintLimit(f.ctx, &types.MsgSetMintLimit{Creator: authority, Id: 0})
+	require.NoError(t, err)
+	_, err = srv.MintToken(f.ctx, &types.MsgMintToken{Creator: creator, Id: 0, Amount: "1000"})
+	require.NoError(t, err)
+}
@@ -12,6 +12,7 @@ import (
 
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types" // New: Needed for coin operations and UnwrapSDKContext
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -30,8 +31,17 @@ func (k msgServer) CreateToken(goCtx context.Context, msg *types.MsgCreateToken)
 		return nil, errorsmod.Wrapf(types.ErrTokenAlreadyExists, "token with symbol %s already exists", msg.Symbol)
 	}
 
+	// Symbols of wrappable denoms are reserved for their wrapped token
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, reserved := params.WrappableDenomBySymbol(msg.Symbol); reserved {
+		return nil, errorsmod.Wrapf(types.ErrTokenAlreadyExists, "symbol %s is reserved for a wrapped token", msg.Symbol)
+	}
+
 	// Convert totalSupply string to a proper sdk.Int for calculations
-	totalSupplyInt, ok := sdk.NewIntFromString(msg.TotalSupply)
+	totalSupplyInt, ok := math.NewIntFromString(msg.TotalSupply)
 	if !ok {
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid total supply: %s", msg.TotalSupply)
 	}
@@ -39,6 +49,11 @@ func (k msgServer) CreateToken(goCtx context.Context, msg *types.MsgCreateToken)
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "total supply cannot be negative")
 	}
 
+	decimals, err := strconv.ParseUint(msg.Decimals, 10, 32)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid decimals: %s", msg.Decimals)
+	}
+
 	nextId, err := k.TokenSeq.Next(ctx)
 	if err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "failed to get next id")
@@ -49,7 +64,7 @@ func (k msgServer) CreateToken(goCtx context.Context, msg *types.MsgCreateToken)
 		Creator:     msg.Creator,
 		Name:        msg.Name,
 		Symbol:      msg.Symbol,
-		Decimals:    msg.Decimals,
+		Decimals:    uint32(decimals),
 		TotalSupply: msg.TotalSupply, // Store as string
 		Metadata:    msg.Metadata,
 	}
@@ -64,9 +79,12 @@ func (k msgServer) CreateToken(goCtx context.Context, msg *types.MsgCreateToken)
 
 	// Mint the initial supply and send it to the creator
 	// Define the coin for the new token. The denom will be the token symbol.
-	if !sdk.IsValidDenom(msg.Symbol) {
+	if err := sdk.ValidateDenom(msg.Symbol); err != nil {
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid token symbol for denom: %s", msg.Symbol)
 	}
+	if err := k.checkDenomUnused(ctx, msg.Symbol); err != nil {
+		return nil, err
+	}
 	coin := sdk.NewCoin(msg.Symbol, totalSupplyInt)
 	coins := sdk.NewCoins(coin)
 
@@ -83,6 +101,10 @@ func (k msgServer) CreateToken(goCtx context.Context, msg *types.MsgCreateToken)
 		return nil, errorsmod.Wrapf(sdkerrors.ErrLogic, "failed to send minted coins to creator: %v", err)
 	}
 
+	if err := k.Hooks().AfterTokenCreated(ctx, token); err != nil {
+		return nil, err
+	}
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(types.EventTypeCreateToken,
 			sdk.NewAttribute(types.AttributeKeyTokenID, strconv.FormatUint(nextId, 10)),
@@ -105,16 +127,6 @@ func (k msgServer) UpdateToken(ctx context.Context, msg *types.MsgUpdateToken) (
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
 	}
 
-	var token = types.Token{
-		Creator:     msg.Creator,
-		Id:          msg.Id,
-		Name:        msg.Name,
-		Symbol:      msg.Symbol,
-		Decimals:    msg.Decimals,
-		TotalSupply: msg.TotalSupply,
-		Metadata:    msg.Metadata,
-	}
-
 	// Checks that the element exists
 	val, err := k.Token.Get(ctx, msg.Id)
 	if err != nil {
@@ -130,6 +142,29 @@ func (k msgServer) UpdateToken(ctx context.Context, msg *types.MsgUpdateToken) (
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
 	}
 
+	// The symbol is the bank denom of the token and the decimals define how its
+	// amounts are displayed; both are fixed when the token is created.
+	if msg.Symbol != val.Symbol {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "token symbol %s cannot be changed", val.Symbol)
+	}
+	decimals, err := strconv.ParseUint(msg.Decimals, 10, 32)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid decimals: %s", msg.Decimals)
+	}
+	if uint32(decimals) != val.Decimals {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "token decimals %d cannot be changed", val.Decimals)
+	}
+
+	var token = types.Token{
+		Creator:     msg.Creator,
+		Id:          msg.Id,
+		Name:        msg.Name,
+		Symbol:      val.Symbol,
+		Decimals:    val.Decimals,
+		TotalSupply: val.TotalSupply, // only changed by minting
+		Metadata:    msg.Metadata,
+	}
+
 	if err := k.Token.Set(ctx, msg.Id, token); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update token")
 	}
@@ -161,5 +196,24 @@ func (k msgServer) DeleteToken(ctx context.Context, msg *types.MsgDeleteToken) (
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to delete token")
 	}
 
+	if err := k.MintLimit.Remove(ctx, msg.Id); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to delete mint limit")
+	}
+
+	if err := k.Hooks().AfterTokenDeleted(ctx, msg.Id); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgDeleteTokenResponse{}, nil
 }
+
+// checkDenomUnused fails if denom already has a supply or denom metadata in
+// the bank module. A new token must not share its denom with coins it does not
+// account for, such as the staking denom, IBC vouchers or the outstanding
+// supply of a deleted token.
+func (k Keeper) checkDenomUnused(ctx context.Context, denom string) error {
+	if k.bankKeeper.HasSupply(ctx, denom) || k.bankKeeper.HasDenomMetaData(ctx, denom) {
+		return errorsmod.Wrapf(types.ErrTokenAlreadyExists, "denom %s is already in use", denom)
+	}
+	return nil
+}
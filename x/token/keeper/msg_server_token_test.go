@@ -1,8 +1,10 @@
 package keeper_test
 
 import (
+	"fmt"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 
@@ -18,12 +20,39 @@ func TestTokenMsgServerCreate(t *testing.T) {
 	require.NoError(t, err)
 
 	for i := 0; i < 5; i++ {
-		resp, err := srv.CreateToken(f.ctx, &types.MsgCreateToken{Creator: creator})
+		resp, err := srv.CreateToken(f.ctx, &types.MsgCreateToken{
+			Creator:     creator,
+			Symbol:      fmt.Sprintf("token%d", i),
+			Decimals:    "6",
+			TotalSupply: "1000",
+		})
 		require.NoError(t, err)
 		require.Equal(t, i, int(resp.Id))
 	}
 }
 
+func TestTokenMsgServerCreateDenomInUse(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	// Coins of a denom that is not in the registry, such as the staking denom
+	require.NoError(t, f.bankKeeper.MintCoins(f.ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))))
+
+	_, err = srv.CreateToken(f.ctx, &types.MsgCreateToken{Creator: creator, Symbol: "stake", Decimals: "6", TotalSupply: "1000"})
+	require.ErrorIs(t, err, types.ErrTokenAlreadyExists)
+
+	// Re-creating a deleted token must not mint more of its outstanding denom
+	_, err = srv.CreateToken(f.ctx, &types.MsgCreateToken{Creator: creator, Symbol: "token", Decimals: "6", TotalSupply: "1000"})
+	require.NoError(t, err)
+	_, err = srv.DeleteToken(f.ctx, &types.MsgDeleteToken{Creator: creator, Id: 0})
+	require.NoError(t, err)
+	_, err = srv.CreateToken(f.ctx, &types.MsgCreateToken{Creator: creator, Symbol: "token", Decimals: "6", TotalSupply: "1000"})
+	require.ErrorIs(t, err, types.ErrTokenAlreadyExists)
+}
+
 func TestTokenMsgServerUpdate(t *testing.T) {
 	f := initFixture(t)
 	srv := keeper.NewMsgServerImpl(f.keeper)
@@ -34,7 +63,7 @@ func TestTokenMsgServerUpdate(t *testing.T) {
 	unauthorizedAddr, err := f.addressCodec.BytesToString([]byte("unauthorizedAddr___________"))
 	require.NoError(t, err)
 
-	_, err = srv.CreateToken(f.ctx, &types.MsgCreateToken{Creator: creator})
+	_, err = srv.CreateToken(f.ctx, &types.MsgCreateToken{Creator: creator, Symbol: "token", Decimals: "6", TotalSupply: "1000"})
 	require.NoError(t, err)
 
 	tests := []struct {
@@ -57,9 +86,19 @@ func TestTokenMsgServerUpdate(t *testing.T) {
 			request: &types.MsgUpdateToken{Creator: creator, Id: 10},
 			err:     sdkerrors.ErrKeyNotFound,
 		},
+		{
+			desc:    "symbol changed",
+			request: &types.MsgUpdateToken{Creator: creator, Symbol: "stake", Decimals: "6", TotalSupply: "1000"},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
+		{
+			desc:    "decimals changed",
+			request: &types.MsgUpdateToken{Creator: creator, Symbol: "token", Decimals: "18", TotalSupply: "1000"},
+			err:     sdkerrors.ErrInvalidRequest,
+		},
 		{
 			desc:    "completed",
-			request: &types.MsgUpdateToken{Creator: creator},
+			request: &types.MsgUpdateToken{Creator: creator, Name: "Token", Symbol: "token", Decimals: "6", TotalSupply: "5"},
 		},
 	}
 	for _, tc := range tests {
@@ -72,6 +111,12 @@ func TestTokenMsgServerUpdate(t *testing.T) {
 			}
 		})
 	}
+
+	token, err := f.keeper.Token.Get(f.ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, "Token", token.Name)
+	require.Equal(t, "token", token.Symbol)
+	require.Equal(t, "1000", token.TotalSupply)
 }
 
 func TestTokenMsgServerDelete(t *testing.T) {
@@ -84,7 +129,9 @@ func TestTokenMsgServerDelete(t *testing.T) {
 	unauthorizedAddr, err := f.addressCodec.BytesToString([]byte("unauthorizedAddr___________"))
 	require.NoError(t, err)
 
-	_, err = srv.CreateToken(f.ctx, &types.MsgCreateToken{Creator: creator})
+	_, err = srv.CreateToken(f.ctx, &types.MsgCreateToken{Creator: creator, Symbol: "token", Decimals: "6", TotalSupply: "1000"})
+	require.NoError(t, err)
+	_, err = srv.SetMintLimit(f.ctx, &types.MsgSetMintLimit{Creator: creator, EpochIdentifier: "day", MaxAmount: "10"})
 	require.NoError(t, err)
 
 	tests := []struct {
@@ -122,4 +169,8 @@ func TestTokenMsgServerDelete(t *testing.T) {
 			}
 		})
 	}
+
+	has, err := f.keeper.MintLimit.Has(f.ctx, 0)
+	require.NoError(t, err)
+	require.False(t, has)
 }
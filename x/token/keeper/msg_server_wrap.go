@@ -0,0 +1,265 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"omnis/x/token/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (k msgServer) Wrap(goCtx context.Context, msg *types.MsgWrap) (*types.MsgWrapResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	creatorAddr, err := k.addressCodec.StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid wrap amount: %s", msg.Amount)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	wrappable, found := params.WrappableDenom(msg.Amount.Denom)
+	if !found {
+		return nil, errorsmod.Wrap(types.ErrDenomNotWrappable, msg.Amount.Denom)
+	}
+
+	token, err := k.getOrCreateWrappedToken(ctx, wrappable)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creatorAddr, types.WrapEscrowName, sdk.NewCoins(msg.Amount)); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "failed to escrow %s: %v", msg.Amount, err)
+	}
+
+	wrapped := sdk.NewCoin(token.Symbol, msg.Amount.Amount)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(wrapped)); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrLogic, "failed to mint coins: %v", err)
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, creatorAddr, sdk.NewCoins(wrapped)); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrLogic, "failed to send wrapped coins: %v", err)
+	}
+
+	if err := k.addTotalSupply(ctx, &token, msg.Amount.Amount); err != nil {
+		return nil, err
+	}
+
+	if err := k.Hooks().AfterMint(ctx, token.Id, creatorAddr, msg.Amount.Amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeWrap,
+			sdk.NewAttribute(types.AttributeKeyTokenID, strconv.FormatUint(token.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyWrappedAmount, wrapped.String()),
+		),
+	)
+
+	return &types.MsgWrapResponse{TokenId: token.Id, Wrapped: wrapped}, nil
+}
+
+func (k msgServer) Unwrap(goCtx context.Context, msg *types.MsgUnwrap) (*types.MsgUnwrapResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	creatorAddr, err := k.addressCodec.StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid unwrap amount: %s", msg.Amount)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Unwrapping stays possible after a denom is removed from the params, so
+	// that holders can always redeem; the registry mapping is the reference.
+	underlying := ""
+	if wrappable, found := params.WrappableDenomBySymbol(msg.Amount.Denom); found {
+		underlying = wrappable.Denom
+	} else {
+		err = k.Wrapped.Walk(ctx, nil, func(denom string, tokenID uint64) (bool, error) {
+			token, err := k.Token.Get(ctx, tokenID)
+			if err != nil {
+				return true, err
+			}
+			if token.Symbol == msg.Amount.Denom {
+				underlying = denom
+				return true, nil
+			}
+			return false, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if underlying == "" {
+		return nil, errorsmod.Wrapf(types.ErrDenomNotWrappable, "%s is not a wrapped token", msg.Amount.Denom)
+	}
+
+	tokenID, err := k.Wrapped.Get(ctx, underlying)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrapf(types.ErrDenomNotWrappable, "%s has never been wrapped", underlying)
+		}
+		return nil, err
+	}
+	token, err := k.Token.Get(ctx, tokenID)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to get token")
+	}
+
+	// The coins must be those of the module-created token the underlying denom
+	// is wrapped into, and not of any other token registered under its symbol.
+	moduleAddr, err := k.ModuleAddress()
+	if err != nil {
+		return nil, err
+	}
+	registered, found := k.GetTokenBySymbol(ctx, msg.Amount.Denom)
+	if !found || registered.Id != token.Id || token.Symbol != msg.Amount.Denom || token.Creator != moduleAddr {
+		return nil, errorsmod.Wrapf(types.ErrDenomNotWrappable, "%s is not the wrapped token of %s", msg.Amount.Denom, underlying)
+	}
+
+	if err := k.Hooks().BeforeBurn(ctx, token.Id, creatorAddr, msg.Amount.Amount); err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creatorAddr, types.ModuleName, sdk.NewCoins(msg.Amount)); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "failed to burn %s: %v", msg.Amount, err)
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(msg.Amount)); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrLogic, "failed to burn coins: %v", err)
+	}
+
+	unwrapped := sdk.NewCoin(underlying, msg.Amount.Amount)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.WrapEscrowName, creatorAddr, sdk.NewCoins(unwrapped)); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrLogic, "failed to release escrowed coins: %v", err)
+	}
+
+	if err := k.addTotalSupply(ctx, &token, msg.Amount.Amount.Neg()); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeUnwrap,
+			sdk.NewAttribute(types.AttributeKeyTokenID, strconv.FormatUint(token.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyAmount, unwrapped.String()),
+			sdk.NewAttribute(types.AttributeKeyWrappedAmount, msg.Amount.String()),
+		),
+	)
+
+	return &types.MsgUnwrapResponse{Unwrapped: unwrapped}, nil
+}
+
+// getOrCreateWrappedToken returns the registry token of a wrappable denom,
+// registering it on first use. Wrapped tokens are owned by the token module
+// account so that no admin can mint, update or delete them.
+func (k Keeper) getOrCreateWrappedToken(ctx context.Context, wrappable types.WrappableDenom) (types.Token, error) {
+	tokenID, err := k.Wrapped.Get(ctx, wrappable.Denom)
+	if err == nil {
+		return k.Token.Get(ctx, tokenID)
+	}
+	if !errors.Is(err, collections.ErrNotFound) {
+		return types.Token{}, err
+	}
+
+	if _, found := k.GetTokenBySymbol(ctx, wrappable.Symbol); found {
+		return types.Token{}, errorsmod.Wrapf(types.ErrTokenAlreadyExists, "token with symbol %s already exists", wrappable.Symbol)
+	}
+	if err := k.checkDenomUnused(ctx, wrappable.Symbol); err != nil {
+		return types.Token{}, err
+	}
+
+	moduleAddr, err := k.ModuleAddress()
+	if err != nil {
+		return types.Token{}, err
+	}
+
+	metadata, err := json.Marshal(map[string]string{
+		"underlying_denom": wrappable.Denom,
+		"description":      wrappable.Description,
+	})
+	if err != nil {
+		return types.Token{}, err
+	}
+
+	tokenID, err = k.TokenSeq.Next(ctx)
+	if err != nil {
+		return types.Token{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "failed to get next id")
+	}
+
+	token := types.Token{
+		Id:          tokenID,
+		Name:        wrappable.Name,
+		Symbol:      wrappable.Symbol,
+		Decimals:    wrappable.Decimals,
+		TotalSupply: math.ZeroInt().String(),
+		Metadata:    string(metadata),
+		Creator:     moduleAddr,
+	}
+	if err := k.Token.Set(ctx, tokenID, token); err != nil {
+		return types.Token{}, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to set token")
+	}
+	if err := k.Wrapped.Set(ctx, wrappable.Denom, tokenID); err != nil {
+		return types.Token{}, err
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Description: wrappable.Description,
+		DenomUnits:  []*banktypes.DenomUnit{{Denom: wrappable.Symbol, Exponent: 0}},
+		Base:        wrappable.Symbol,
+		Display:     wrappable.Symbol,
+		Name:        wrappable.Name,
+		Symbol:      wrappable.Symbol,
+	})
+
+	if err := k.Hooks().AfterTokenCreated(ctx, token); err != nil {
+		return types.Token{}, err
+	}
+
+	return token, nil
+}
+
+// addTotalSupply adds delta, which may be negative, to the recorded total
+// supply of a token and stores it.
+func (k Keeper) addTotalSupply(ctx context.Context, token *types.Token, delta math.Int) error {
+	totalSupply := math.ZeroInt()
+	if token.TotalSupply != "" {
+		var ok bool
+		totalSupply, ok = math.NewIntFromString(token.TotalSupply)
+		if !ok {
+			return errorsmod.Wrapf(sdkerrors.ErrLogic, "invalid stored total supply: %s", token.TotalSupply)
+		}
+	}
+
+	totalSupply = totalSupply.Add(delta)
+	if totalSupply.IsNegative() {
+		return errorsmod.Wrapf(sdkerrors.ErrLogic, "total supply of token %d cannot be negative", token.Id)
+	}
+	token.TotalSupply = totalSupply.String()
+
+	if err := k.Token.Set(ctx, token.Id, *token); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update token")
+	}
+	return nil
+}
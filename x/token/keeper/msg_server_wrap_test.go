@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"omnis/x/token/keeper"
+	"omnis/x/token/types"
+)
+
+func TestTokenMsgServerWrap(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	creatorAddr := sdk.MustAccAddressFromBech32(creator)
+	f.bankKeeper.balances[creator] = sdk.NewCoins(sdk.NewInt64Coin("stake", 1000), sdk.NewInt64Coin("atom", 1000))
+
+	params := types.DefaultParams()
+	params.WrappableDenoms = []types.WrappableDenom{{Denom: "stake", Symbol: "wstake", Name: "Wrapped Stake", Decimals: 6}}
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	tests := []struct {
+		desc    string
+		request *types.MsgWrap
+		err     error
+	}{
+		{
+			desc:    "invalid address",
+			request: &types.MsgWrap{Creator: "invalid", Amount: sdk.NewInt64Coin("stake", 1)},
+			err:     sdkerrors.ErrInvalidAddress,
+		},
+		{
+			desc:    "invalid amount",
+			request: &types.MsgWrap{Creator: creator, Amount: sdk.NewInt64Coin("stake", 0)},
+			err:     sdkerrors.ErrInvalidCoins,
+		},
+		{
+			desc:    "denom not wrappable",
+			request: &types.MsgWrap{Creator: creator, Amount: sdk.NewInt64Coin("atom", 1)},
+			err:     types.ErrDenomNotWrappable,
+		},
+		{
+			desc:    "insufficient funds",
+			request: &types.MsgWrap{Creator: creator, Amount: sdk.NewInt64Coin("stake", 5000)},
+			err:     sdkerrors.ErrInsufficientFunds,
+		},
+		{
+			desc:    "completed",
+			request: &types.MsgWrap{Creator: creator, Amount: sdk.NewInt64Coin("stake", 400)},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err = srv.Wrap(f.ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	tokenID, err := f.keeper.Wrapped.Get(f.ctx, "stake")
+	require.NoError(t, err)
+	token, err := f.keeper.Token.Get(f.ctx, tokenID)
+	require.NoError(t, err)
+	require.Equal(t, "wstake", token.Symbol)
+	require.Equal(t, "400", token.TotalSupply)
+	require.Equal(t, math.NewInt(400), f.bankKeeper.GetBalance(f.ctx, creatorAddr, "wstake").Amount)
+	require.Equal(t, math.NewInt(600), f.bankKeeper.GetBalance(f.ctx, creatorAddr, "stake").Amount)
+
+	msg, broken := keeper.WrapEscrowInvariant(f.keeper)(sdk.UnwrapSDKContext(f.ctx))
+	require.False(t, broken, msg)
+
+	// The wrapped token cannot be administered or burnt outside of MsgUnwrap
+	_, err = srv.BurnToken(f.ctx, &types.MsgBurnToken{Creator: creator, Id: tokenID, Amount: "1"})
+	require.ErrorIs(t, err, types.ErrWrappedToken)
+	_, err = srv.CreateToken(f.ctx, &types.MsgCreateToken{Creator: creator, Symbol: "wstake", TotalSupply: "1"})
+	require.ErrorIs(t, err, types.ErrTokenAlreadyExists)
+
+	_, err = srv.Unwrap(f.ctx, &types.MsgUnwrap{Creator: creator, Amount: sdk.NewInt64Coin("wstake", 500)})
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	res, err := srv.Unwrap(f.ctx, &types.MsgUnwrap{Creator: creator, Amount: sdk.NewInt64Coin("wstake", 150)})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("stake", 150), res.Unwrapped)
+
+	token, err = f.keeper.Token.Get(f.ctx, tokenID)
+	require.NoError(t, err)
+	require.Equal(t, "250", token.TotalSupply)
+	require.Equal(t, math.NewInt(750), f.bankKeeper.GetBalance(f.ctx, creatorAddr, "stake").Amount)
+
+	msg, broken = keeper.WrapEscrowInvariant(f.keeper)(sdk.UnwrapSDKContext(f.ctx))
+	require.False(t, broken, msg)
+}
+
+func TestTokenMsgServerUnwrapForeignToken(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	f.bankKeeper.balances[creator] = sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+
+	params := types.DefaultParams()
+	params.WrappableDenoms = []types.WrappableDenom{{Denom: "stake", Symbol: "wstake", Name: "Wrapped Stake", Decimals: 6}}
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	// A user token registered under the wrapped symbol before the wrapped
+	// token, e.g. by a rename that is no longer possible
+	require.NoError(t, f.keeper.Token.Set(f.ctx, 0, types.Token{Id: 0, Symbol: "wstake", TotalSupply: "0", Creator: creator}))
+	_, err = f.keeper.TokenSeq.Next(f.ctx)
+	require.NoError(t, err)
+	_, err = srv.Wrap(f.ctx, &types.MsgWrap{Creator: creator, Amount: sdk.NewInt64Coin("stake", 400)})
+	require.ErrorIs(t, err, types.ErrTokenAlreadyExists)
+
+	// The same token added after the wrapped token cannot drain the escrow
+	require.NoError(t, f.keeper.Token.Remove(f.ctx, 0))
+	_, err = srv.Wrap(f.ctx, &types.MsgWrap{Creator: creator, Amount: sdk.NewInt64Coin("stake", 400)})
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.Token.Set(f.ctx, 0, types.Token{Id: 0, Symbol: "wstake", TotalSupply: "0", Creator: creator}))
+
+	_, err = srv.Unwrap(f.ctx, &types.MsgUnwrap{Creator: creator, Amount: sdk.NewInt64Coin("wstake", 100)})
+	require.ErrorIs(t, err, types.ErrDenomNotWrappable)
+}
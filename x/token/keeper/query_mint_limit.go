@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"omnis/x/token/types"
+
+	"cosmossdk.io/collections"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) GetMintLimit(ctx context.Context, req *types.QueryGetMintLimitRequest) (*types.QueryGetMintLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	limit, err := q.k.MintLimit.Get(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetMintLimitResponse{MintLimit: limit}, nil
+}
@@ -22,7 +22,7 @@ func createNToken(keeper keeper.Keeper, ctx context.Context, n int) []types.Toke
 		items[i].Id = iu
 		items[i].Name = strconv.Itoa(i)
 		items[i].Symbol = strconv.Itoa(i)
-		items[i].Decimals = strconv.Itoa(i)
+		items[i].Decimals = uint32(i)
 		items[i].TotalSupply = strconv.Itoa(i)
 		items[i].Metadata = strconv.Itoa(i)
 		_ = keeper.Token.Set(ctx, iu, items[i])
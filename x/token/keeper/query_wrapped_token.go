@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"omnis/x/token/types"
+
+	"cosmossdk.io/collections"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) GetWrappedToken(ctx context.Context, req *types.QueryGetWrappedTokenRequest) (*types.QueryGetWrappedTokenResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	tokenID, err := q.k.Wrapped.Get(ctx, req.Denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, sdkerrors.ErrKeyNotFound
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	token, err := q.k.Token.Get(ctx, tokenID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetWrappedTokenResponse{Token: token}, nil
+}
@@ -29,6 +29,19 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Alias:          []string{"show-token"},
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
 				},
+				{
+					RpcMethod:      "GetMintLimit",
+					Use:            "get-mint-limit [id]",
+					Short:          "Gets the per-epoch mint limit of a token",
+					Alias:          []string{"show-mint-limit"},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "GetWrappedToken",
+					Use:            "get-wrapped-token [denom]",
+					Short:          "Gets the token a native or IBC denom is wrapped into",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -40,6 +53,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "UpdateParams",
 					Skip:      true, // skipped because authority gated
 				},
+				{
+					RpcMethod: "FundFeeLiquidity",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "WithdrawFeeLiquidity",
+					Skip:      true, // skipped because authority gated
+				},
 				{
 					RpcMethod:      "CreateToken",
 					Use:            "create-token [name] [symbol] [decimals] [total-supply] [metadata]",
@@ -58,6 +79,40 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Delete token",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
 				},
+				{
+					RpcMethod:      "MintToken",
+					Use:            "mint-token [id] [amount]",
+					Short:          "Mint additional supply of a token",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "amount"}},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"recipient": {Usage: "address receiving the minted coins (defaults to the sender)"},
+					},
+				},
+				{
+					RpcMethod:      "SetMintLimit",
+					Use:            "set-mint-limit [id] [epoch-identifier] [max-amount]",
+					Short:          "Set the maximum amount of a token that can be minted per epoch",
+					Long:           "Set the maximum amount of a token that can be minted per epoch. Pass an empty epoch identifier to remove the limit (module authority only).",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "epoch_identifier"}, {ProtoField: "max_amount"}},
+				},
+				{
+					RpcMethod:      "BurnToken",
+					Use:            "burn-token [id] [amount]",
+					Short:          "Burn an amount of a token from the sender's balance",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "Wrap",
+					Use:            "wrap [amount]",
+					Short:          "Wrap native or IBC coins 1:1 into their OMS-20 token",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "Unwrap",
+					Use:            "unwrap [amount]",
+					Short:          "Unwrap OMS-20 wrapped tokens 1:1 into the escrowed coins",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
@@ -1,6 +1,10 @@
 package token
 
 import (
+	"fmt"
+	"maps"
+	"slices"
+
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/store"
@@ -8,6 +12,7 @@ import (
 	"cosmossdk.io/depinject/appconfig"
 	"github.com/cosmos/cosmos-sdk/codec"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
 
 	"omnis/x/token/keeper"
 	"omnis/x/token/types"
@@ -35,6 +40,10 @@ type ModuleInputs struct {
 
 	AuthKeeper types.AuthKeeper
 	BankKeeper types.BankKeeper
+
+	// TokenHooks are provided by other modules through a types.TokenHooksWrapper
+	// output. Such modules must not depend on the token keeper themselves.
+	TokenHooks map[string]types.TokenHooksWrapper `optional:"true"`
 }
 
 type ModuleOutputs struct {
@@ -42,9 +51,10 @@ type ModuleOutputs struct {
 
 	TokenKeeper keeper.Keeper
 	Module      appmodule.AppModule
+	EpochHooks  epochstypes.EpochHooksWrapper
 }
 
-func ProvideModule(in ModuleInputs) ModuleOutputs {
+func ProvideModule(in ModuleInputs) (ModuleOutputs, error) {
 	// default to governance authority if not provided
 	authority := authtypes.NewModuleAddress(types.GovModuleName)
 	if in.Config.Authority != "" {
@@ -55,8 +65,48 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.Cdc,
 		in.AddressCodec,
 		authority,
+		in.AuthKeeper,
+		in.BankKeeper,
 	)
+
+	hooks, err := orderTokenHooks(in.Config.HooksOrder, in.TokenHooks)
+	if err != nil {
+		return ModuleOutputs{}, err
+	}
+	if len(hooks) > 0 {
+		k.SetHooks(hooks)
+	}
+
 	m := NewAppModule(in.Cdc, k, in.AuthKeeper, in.BankKeeper)
 
-	return ModuleOutputs{TokenKeeper: k, Module: m}
+	return ModuleOutputs{
+		TokenKeeper: k,
+		Module:      m,
+		EpochHooks:  epochstypes.EpochHooksWrapper{EpochHooks: k.EpochHooks()},
+	}, nil
+}
+
+// orderTokenHooks combines the injected token hooks following the configured
+// order, defaulting to the alphabetical order of the providing module names.
+func orderTokenHooks(order []string, tokenHooks map[string]types.TokenHooksWrapper) (types.MultiTokenHooks, error) {
+	modNames := slices.Sorted(maps.Keys(tokenHooks))
+	if len(order) == 0 {
+		order = modNames
+	}
+
+	if len(order) != len(modNames) {
+		return nil, fmt.Errorf("len(hooks_order: %v) != len(hooks modules: %v)", order, modNames)
+	}
+
+	var multiHooks types.MultiTokenHooks
+	for _, modName := range order {
+		hook, ok := tokenHooks[modName]
+		if !ok {
+			return nil, fmt.Errorf("can't find token hooks for module %s", modName)
+		}
+
+		multiHooks = append(multiHooks, hook)
+	}
+
+	return multiHooks, nil
 }
@@ -0,0 +1,75 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"omnis/testutil/sample"
+	"omnis/x/token/types"
+)
+
+func TestMintAuthorization(t *testing.T) {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+
+	admin := sample.AccAddress()
+	hotWallet := sample.AccAddress()
+
+	require.Error(t, types.NewMintAuthorization(1, math.NewInt(100), []string{"invalid"}).ValidateBasic())
+	require.Error(t, types.NewMintAuthorization(1, math.NewInt(100), []string{admin, admin}).ValidateBasic())
+
+	auth := types.NewMintAuthorization(1, math.NewInt(100), []string{admin})
+	require.NoError(t, auth.ValidateBasic())
+	require.Equal(t, sdk.MsgTypeURL(&types.MsgMintToken{}), auth.MsgTypeURL())
+
+	// wrong token
+	_, err := auth.Accept(ctx, types.NewMsgMintToken(admin, 2, "10", ""))
+	require.Error(t, err)
+
+	// recipient not in allow list
+	_, err = auth.Accept(ctx, types.NewMsgMintToken(admin, 1, "10", hotWallet))
+	require.Error(t, err)
+
+	// over the spend limit
+	_, err = auth.Accept(ctx, types.NewMsgMintToken(admin, 1, "101", ""))
+	require.Error(t, err)
+
+	resp, err := auth.Accept(ctx, types.NewMsgMintToken(admin, 1, "40", ""))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	require.Equal(t, "60", resp.Updated.(*types.MintAuthorization).SpendLimit)
+
+	resp, err = resp.Updated.Accept(ctx, types.NewMsgMintToken(admin, 1, "60", admin))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.True(t, resp.Delete)
+}
+
+func TestTokenAdminAuthorization(t *testing.T) {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+
+	admin := sample.AccAddress()
+
+	require.Error(t, types.NewTokenAdminAuthorization(sdk.MsgTypeURL(&types.MsgMintToken{})).ValidateBasic())
+	require.Error(t, types.NewTokenAdminAuthorization(sdk.MsgTypeURL(&types.MsgUpdateToken{}), 1, 1).ValidateBasic())
+
+	auth := types.NewTokenAdminAuthorization(sdk.MsgTypeURL(&types.MsgUpdateToken{}), 1, 2)
+	require.NoError(t, auth.ValidateBasic())
+
+	resp, err := auth.Accept(ctx, types.NewMsgUpdateToken(admin, 2, "name", "sym", "6", "100", ""))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+
+	_, err = auth.Accept(ctx, types.NewMsgUpdateToken(admin, 3, "name", "sym", "6", "100", ""))
+	require.Error(t, err)
+
+	_, err = auth.Accept(ctx, types.NewMsgDeleteToken(admin, 1))
+	require.Error(t, err)
+}
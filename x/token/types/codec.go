@@ -4,6 +4,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
 func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
@@ -11,10 +12,21 @@ func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
 		&MsgCreateToken{},
 		&MsgUpdateToken{},
 		&MsgDeleteToken{},
+		&MsgMintToken{},
+		&MsgSetMintLimit{},
+		&MsgBurnToken{},
+		&MsgWrap{},
+		&MsgUnwrap{},
 	)
 
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgFundFeeLiquidity{},
+		&MsgWithdrawFeeLiquidity{},
+	)
+	registrar.RegisterImplementations((*authz.Authorization)(nil),
+		&MintAuthorization{},
+		&TokenAdminAuthorization{},
 	)
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
 }
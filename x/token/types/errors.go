@@ -8,5 +8,11 @@ import (
 
 // x/token module sentinel errors
 var (
-	ErrInvalidSigner = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidSigner      = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrTokenAlreadyExists = errors.Register(ModuleName, 1101, "token already exists")
+	ErrMintLimitExceeded  = errors.Register(ModuleName, 1102, "mint limit for the current epoch exceeded")
+	ErrInvalidMintLimit   = errors.Register(ModuleName, 1103, "invalid mint limit")
+	ErrFeeTokenNotAllowed = errors.Register(ModuleName, 1104, "denom is not whitelisted for fee payment")
+	ErrDenomNotWrappable  = errors.Register(ModuleName, 1105, "denom is not wrappable")
+	ErrWrappedToken       = errors.Register(ModuleName, 1106, "operation not allowed on a wrapped token")
 )
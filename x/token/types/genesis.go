@@ -5,8 +5,11 @@ import "fmt"
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params:    DefaultParams(),
-		TokenList: []Token{}}
+		Params:           DefaultParams(),
+		TokenList:        []Token{},
+		MintLimitList:    []MintLimit{},
+		WrappedTokenList: []WrappedToken{},
+	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
@@ -24,5 +27,32 @@ func (gs GenesisState) Validate() error {
 		tokenIdMap[elem.Id] = true
 	}
 
+	mintLimitMap := make(map[uint64]bool)
+	for _, elem := range gs.MintLimitList {
+		if _, ok := mintLimitMap[elem.TokenId]; ok {
+			return fmt.Errorf("duplicated mint limit for token %d", elem.TokenId)
+		}
+		if !tokenIdMap[elem.TokenId] {
+			return fmt.Errorf("mint limit references unknown token %d", elem.TokenId)
+		}
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		mintLimitMap[elem.TokenId] = true
+	}
+
+	wrappedMap := make(map[string]bool)
+	wrappedTokenMap := make(map[uint64]bool)
+	for _, elem := range gs.WrappedTokenList {
+		if wrappedMap[elem.Denom] || wrappedTokenMap[elem.TokenId] {
+			return fmt.Errorf("duplicated wrapped token %s (%d)", elem.Denom, elem.TokenId)
+		}
+		if !tokenIdMap[elem.TokenId] {
+			return fmt.Errorf("wrapped token %s references unknown token %d", elem.Denom, elem.TokenId)
+		}
+		wrappedMap[elem.Denom] = true
+		wrappedTokenMap[elem.TokenId] = true
+	}
+
 	return gs.Params.Validate()
 }
@@ -27,9 +27,11 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the token module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params     Params  `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	TokenList  []Token `protobuf:"bytes,2,rep,name=token_list,json=tokenList,proto3" json:"token_list"`
-	TokenCount uint64  `protobuf:"varint,3,opt,name=token_count,json=tokenCount,proto3" json:"token_count,omitempty"`
+	Params           Params         `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	TokenList        []Token        `protobuf:"bytes,2,rep,name=token_list,json=tokenList,proto3" json:"token_list"`
+	TokenCount       uint64         `protobuf:"varint,3,opt,name=token_count,json=tokenCount,proto3" json:"token_count,omitempty"`
+	MintLimitList    []MintLimit    `protobuf:"bytes,4,rep,name=mint_limit_list,json=mintLimitList,proto3" json:"mint_limit_list"`
+	WrappedTokenList []WrappedToken `protobuf:"bytes,5,rep,name=wrapped_token_list,json=wrappedTokenList,proto3" json:"wrapped_token_list"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -86,6 +88,20 @@ func (m *GenesisState) GetTokenCount() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetMintLimitList() []MintLimit {
+	if m != nil {
+		return m.MintLimitList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWrappedTokenList() []WrappedToken {
+	if m != nil {
+		return m.WrappedTokenList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "omnis.token.v1.GenesisState")
 }
@@ -93,24 +109,28 @@ func init() {
 func init() { proto.RegisterFile("omnis/token/v1/genesis.proto", fileDescriptor_e58b6370d220d88c) }
 
 var fileDescriptor_e58b6370d220d88c = []byte{
-	// 259 bytes of a gzipped FileDescriptorProto
+	// 329 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xc9, 0xcf, 0xcd, 0xcb,
 	0x2c, 0xd6, 0x2f, 0xc9, 0xcf, 0x4e, 0xcd, 0xd3, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d,
 	0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x03, 0xcb, 0xea, 0x81, 0x65, 0xf5,
 	0xca, 0x0c, 0xa5, 0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x89, 0x94, 0x48,
 	0x7a, 0x7e, 0x7a, 0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x45, 0xa5, 0xd1, 0x8c, 0x2d, 0x48, 0x2c,
-	0x4a, 0xcc, 0x85, 0x9a, 0x2a, 0x25, 0x85, 0x26, 0x09, 0x31, 0x1e, 0x2c, 0xa7, 0xb4, 0x8c, 0x91,
+	0x4a, 0xcc, 0x85, 0x9a, 0x2a, 0x25, 0x85, 0x26, 0x09, 0x31, 0x1e, 0x2c, 0xa7, 0x74, 0x90, 0x89,
 	0x8b, 0xc7, 0x1d, 0xe2, 0x86, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x4b, 0x2e, 0x36, 0x88, 0x66,
 	0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x31, 0x3d, 0x54, 0x37, 0xe9, 0x05, 0x80, 0x65, 0x9d,
 	0x38, 0x4f, 0xdc, 0x93, 0x67, 0x58, 0xf1, 0x7c, 0x83, 0x16, 0x63, 0x10, 0x54, 0x83, 0x90, 0x15,
 	0x17, 0x17, 0x58, 0x55, 0x7c, 0x4e, 0x66, 0x71, 0x89, 0x04, 0x93, 0x02, 0xb3, 0x06, 0xb7, 0x91,
 	0x28, 0xba, 0xf6, 0x10, 0x10, 0xc3, 0x89, 0x05, 0xa4, 0x3b, 0x88, 0x13, 0x2c, 0xea, 0x93, 0x59,
 	0x5c, 0x22, 0x24, 0xcf, 0xc5, 0x0d, 0xd1, 0x9b, 0x9c, 0x5f, 0x9a, 0x57, 0x22, 0xc1, 0xac, 0xc0,
-	0xa8, 0xc1, 0x12, 0x04, 0x31, 0xce, 0x19, 0x24, 0xe2, 0xa4, 0x7b, 0xe2, 0x91, 0x1c, 0xe3, 0x85,
-	0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3,
-	0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xc2, 0x10, 0xef, 0x55, 0x40, 0x3d, 0x58, 0x52, 0x59, 0x90, 0x5a,
-	0x9c, 0xc4, 0x06, 0xf6, 0x9e, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xad, 0xd3, 0x35, 0x41, 0x70,
-	0x01, 0x00, 0x00,
+	0xa8, 0xc1, 0x12, 0x04, 0x31, 0xce, 0x19, 0x24, 0x22, 0xe4, 0xce, 0xc5, 0x9f, 0x9b, 0x99, 0x57,
+	0x12, 0x9f, 0x93, 0x99, 0x9b, 0x59, 0x02, 0xb1, 0x81, 0x05, 0x6c, 0x83, 0x24, 0xba, 0x0d, 0xbe,
+	0x99, 0x79, 0x25, 0x3e, 0x20, 0x55, 0x50, 0x5b, 0x78, 0x73, 0x61, 0x02, 0x60, 0x9b, 0x02, 0xb8,
+	0x84, 0xca, 0x8b, 0x12, 0x0b, 0x0a, 0x52, 0x53, 0xe2, 0x91, 0x5c, 0xcb, 0x0a, 0x36, 0x4b, 0x06,
+	0xdd, 0xac, 0x70, 0x88, 0x4a, 0x64, 0x47, 0x0b, 0x94, 0x23, 0x89, 0x81, 0x4c, 0x74, 0xd2, 0x3d,
+	0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63,
+	0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x61, 0x48, 0xc8, 0x57, 0x40, 0xc3,
+	0xbe, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c, 0xf2, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff,
+	0xff, 0x73, 0xa1, 0xe4, 0x4b, 0x0b, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -133,6 +153,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.WrappedTokenList) > 0 {
+		for iNdEx := len(m.WrappedTokenList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WrappedTokenList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.MintLimitList) > 0 {
+		for iNdEx := len(m.MintLimitList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintLimitList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.TokenCount != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.TokenCount))
 		i--
@@ -193,6 +241,18 @@ func (m *GenesisState) Size() (n int) {
 	if m.TokenCount != 0 {
 		n += 1 + sovGenesis(uint64(m.TokenCount))
 	}
+	if len(m.MintLimitList) > 0 {
+		for _, e := range m.MintLimitList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WrappedTokenList) > 0 {
+		for _, e := range m.WrappedTokenList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -317,6 +377,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintLimitList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintLimitList = append(m.MintLimitList, MintLimit{})
+			if err := m.MintLimitList[len(m.MintLimitList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WrappedTokenList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WrappedTokenList = append(m.WrappedTokenList, WrappedToken{})
+			if err := m.WrappedTokenList[len(m.WrappedTokenList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TokenHooks is the interface other modules implement to react to token
+// lifecycle events. Returning an error aborts the operation.
+type TokenHooks interface {
+	AfterTokenCreated(ctx context.Context, token Token) error
+	AfterMint(ctx context.Context, tokenID uint64, recipient sdk.AccAddress, amount math.Int) error
+	BeforeBurn(ctx context.Context, tokenID uint64, burner sdk.AccAddress, amount math.Int) error
+	AfterTokenDeleted(ctx context.Context, tokenID uint64) error
+}
+
+// TokenHooksWrapper is a wrapper for modules to inject TokenHooks using depinject.
+type TokenHooksWrapper struct{ TokenHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (TokenHooksWrapper) IsOnePerModuleType() {}
+
+// combine multiple token hooks, all hook functions are run in array sequence
+var _ TokenHooks = MultiTokenHooks{}
+
+type MultiTokenHooks []TokenHooks
+
+func NewMultiTokenHooks(hooks ...TokenHooks) MultiTokenHooks {
+	return hooks
+}
+
+func (h MultiTokenHooks) AfterTokenCreated(ctx context.Context, token Token) error {
+	for i := range h {
+		if err := h[i].AfterTokenCreated(ctx, token); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiTokenHooks) AfterMint(ctx context.Context, tokenID uint64, recipient sdk.AccAddress, amount math.Int) error {
+	for i := range h {
+		if err := h[i].AfterMint(ctx, tokenID, recipient, amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiTokenHooks) BeforeBurn(ctx context.Context, tokenID uint64, burner sdk.AccAddress, amount math.Int) error {
+	for i := range h {
+		if err := h[i].BeforeBurn(ctx, tokenID, burner, amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiTokenHooks) AfterTokenDeleted(ctx context.Context, tokenID uint64) error {
+	for i := range h {
+		if err := h[i].AfterTokenDeleted(ctx, tokenID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -13,6 +13,41 @@ const (
 	// It should be synced with the gov module's name if it is ever changed.
 	// See: https://github.com/cosmos/cosmos-sdk/blob/v0.52.0-beta.2/x/gov/types/keys.go#L9
 	GovModuleName = "gov"
+
+	// FeeLiquidityName is the module account holding the native liquidity that
+	// fees paid in OMS-20 tokens are converted from. It also collects those
+	// fees, and is only funded and drained through the module authority.
+	FeeLiquidityName = "token_fee_liquidity"
+
+	// WrapEscrowName is the module account holding the coins escrowed by MsgWrap.
+	WrapEscrowName = ModuleName + "_wrap_escrow"
+
+	// Event types
+	EventTypeCreateToken          = "create_token"
+	EventTypeMintToken            = "mint_token"
+	EventTypeBurnToken            = "burn_token"
+	EventTypeSetMintLimit         = "set_mint_limit"
+	EventTypeFeeAbstraction       = "fee_abstraction"
+	EventTypeFundFeeLiquidity     = "fund_fee_liquidity"
+	EventTypeWithdrawFeeLiquidity = "withdraw_fee_liquidity"
+	EventTypeWrap                 = "wrap"
+	EventTypeUnwrap               = "unwrap"
+
+	// Attribute keys for events
+	AttributeKeyTokenID         = "token_id"
+	AttributeKeyTokenName       = "token_name"
+	AttributeKeyTokenSymbol     = "token_symbol"
+	AttributeKeyCreator         = "creator"
+	AttributeKeyTotalSupply     = "total_supply"
+	AttributeKeyRecipient       = "recipient"
+	AttributeKeyAmount          = "amount"
+	AttributeKeyEpochIdentifier = "epoch_identifier"
+	AttributeKeyMaxAmount       = "max_amount"
+	AttributeKeyFee             = "fee"
+	AttributeKeyEffectiveFee    = "effective_fee"
+	AttributeKeyConversionRate  = "conversion_rate"
+	AttributeKeyFeePayer        = "fee_payer"
+	AttributeKeyWrappedAmount   = "wrapped_amount"
 )
 
 // ParamsKey is the prefix to retrieve all Params
@@ -21,4 +56,6 @@ var ParamsKey = collections.NewPrefix("p_token")
 var (
 	TokenKey      = collections.NewPrefix("token/value/")
 	TokenCountKey = collections.NewPrefix("token/count/")
+	MintLimitKey  = collections.NewPrefix("token/mint_limit/")
+	WrappedKey    = collections.NewPrefix("token/wrapped/")
 )
@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 func NewMsgCreateToken(creator string, name string, symbol string, decimals string, totalSupply string, metadata string) *MsgCreateToken {
 	return &MsgCreateToken{
 		Creator:     creator,
@@ -29,3 +33,43 @@ func NewMsgDeleteToken(creator string, id uint64) *MsgDeleteToken {
 		Creator: creator,
 	}
 }
+
+func NewMsgMintToken(creator string, id uint64, amount string, recipient string) *MsgMintToken {
+	return &MsgMintToken{
+		Id:        id,
+		Creator:   creator,
+		Amount:    amount,
+		Recipient: recipient,
+	}
+}
+
+func NewMsgSetMintLimit(creator string, id uint64, epochIdentifier string, maxAmount string) *MsgSetMintLimit {
+	return &MsgSetMintLimit{
+		Id:              id,
+		Creator:         creator,
+		EpochIdentifier: epochIdentifier,
+		MaxAmount:       maxAmount,
+	}
+}
+
+func NewMsgBurnToken(creator string, id uint64, amount string) *MsgBurnToken {
+	return &MsgBurnToken{
+		Id:      id,
+		Creator: creator,
+		Amount:  amount,
+	}
+}
+
+func NewMsgWrap(creator string, amount sdk.Coin) *MsgWrap {
+	return &MsgWrap{
+		Creator: creator,
+		Amount:  amount,
+	}
+}
+
+func NewMsgUnwrap(creator string, amount sdk.Coin) *MsgUnwrap {
+	return &MsgUnwrap{
+		Creator: creator,
+		Amount:  amount,
+	}
+}
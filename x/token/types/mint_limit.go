@@ -0,0 +1,60 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+)
+
+// NewMintLimit returns a MintLimit with a zero minted counter.
+func NewMintLimit(tokenID uint64, epochIdentifier string, maxAmount math.Int) MintLimit {
+	return MintLimit{
+		TokenId:         tokenID,
+		EpochIdentifier: epochIdentifier,
+		MaxAmount:       maxAmount.String(),
+		Minted:          math.ZeroInt().String(),
+	}
+}
+
+// Validate performs a stateless validation of the mint limit.
+func (l MintLimit) Validate() error {
+	if l.EpochIdentifier == "" {
+		return errorsmod.Wrap(ErrInvalidMintLimit, "epoch identifier cannot be empty")
+	}
+
+	maxAmount, ok := math.NewIntFromString(l.MaxAmount)
+	if !ok || maxAmount.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidMintLimit, "invalid max amount: %s", l.MaxAmount)
+	}
+
+	minted, err := l.MintedAmount()
+	if err != nil {
+		return err
+	}
+	if minted.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidMintLimit, "minted amount cannot be negative: %s", l.Minted)
+	}
+
+	return nil
+}
+
+// MaxAmountInt returns the max amount as an integer.
+func (l MintLimit) MaxAmountInt() (math.Int, error) {
+	maxAmount, ok := math.NewIntFromString(l.MaxAmount)
+	if !ok {
+		return math.Int{}, errorsmod.Wrapf(ErrInvalidMintLimit, "invalid max amount: %s", l.MaxAmount)
+	}
+	return maxAmount, nil
+}
+
+// MintedAmount returns the amount minted in the current epoch. An empty
+// counter is treated as zero.
+func (l MintLimit) MintedAmount() (math.Int, error) {
+	if l.Minted == "" {
+		return math.ZeroInt(), nil
+	}
+	minted, ok := math.NewIntFromString(l.Minted)
+	if !ok {
+		return math.Int{}, errorsmod.Wrapf(ErrInvalidMintLimit, "invalid minted amount: %s", l.Minted)
+	}
+	return minted, nil
+}
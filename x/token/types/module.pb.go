@@ -28,6 +28,10 @@ type Module struct {
 	// authority defines the custom module authority.
 	// If not set, defaults to the governance module.
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// hooks_order specifies the order of token hooks and should be a list
+	// of module names which provide a token hooks instance. If no order is
+	// provided, then hooks will be applied in alphabetical order of module names.
+	HooksOrder []string `protobuf:"bytes,2,rep,name=hooks_order,json=hooksOrder,proto3" json:"hooks_order,omitempty"`
 }
 
 func (m *Module) Reset()         { *m = Module{} }
@@ -70,6 +74,13 @@ func (m *Module) GetAuthority() string {
 	return ""
 }
 
+func (m *Module) GetHooksOrder() []string {
+	if m != nil {
+		return m.HooksOrder
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Module)(nil), "omnis.token.module.v1.Module")
 }
@@ -79,18 +90,20 @@ func init() {
 }
 
 var fileDescriptor_93cd3bae25098a39 = []byte{
-	// 176 bytes of a gzipped FileDescriptorProto
+	// 204 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xca, 0xcf, 0xcd, 0xcb,
 	0x2c, 0xd6, 0x2f, 0xc9, 0xcf, 0x4e, 0xcd, 0xd3, 0xcf, 0xcd, 0x4f, 0x29, 0xcd, 0x49, 0xd5, 0x2f,
 	0x33, 0x84, 0xb2, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x44, 0xc1, 0x6a, 0xf4, 0xc0, 0x6a,
 	0xf4, 0xa0, 0x32, 0x65, 0x86, 0x52, 0x0a, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0xfa, 0x89, 0x05,
-	0x05, 0xfa, 0x65, 0x86, 0x89, 0x39, 0x05, 0x19, 0x89, 0xa8, 0x1a, 0x95, 0x6c, 0xb9, 0xd8, 0x7c,
+	0x05, 0xfa, 0x65, 0x86, 0x89, 0x39, 0x05, 0x19, 0x89, 0xa8, 0x1a, 0x95, 0xe2, 0xb8, 0xd8, 0x7c,
 	0xc1, 0x7c, 0x21, 0x19, 0x2e, 0xce, 0xc4, 0xd2, 0x92, 0x8c, 0xfc, 0xa2, 0xcc, 0x92, 0x4a, 0x09,
-	0x46, 0x05, 0x46, 0x0d, 0xce, 0x20, 0x84, 0x80, 0x95, 0xe8, 0xae, 0x03, 0xd3, 0x6e, 0x31, 0xf2,
-	0x73, 0xf1, 0x42, 0x1c, 0x53, 0x01, 0x71, 0x8e, 0x93, 0xee, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e,
-	0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37,
-	0x1e, 0xcb, 0x31, 0x44, 0x09, 0xa3, 0x28, 0xd4, 0x2f, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03,
-	0x5b, 0x6a, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x05, 0x1c, 0xd1, 0x5d, 0xd3, 0x00, 0x00, 0x00,
+	0x46, 0x05, 0x46, 0x0d, 0xce, 0x20, 0x84, 0x80, 0x90, 0x3c, 0x17, 0x77, 0x46, 0x7e, 0x7e, 0x76,
+	0x71, 0x7c, 0x7e, 0x51, 0x4a, 0x6a, 0x91, 0x04, 0x93, 0x02, 0xb3, 0x06, 0x67, 0x10, 0x17, 0x58,
+	0xc8, 0x1f, 0x24, 0x62, 0x25, 0xba, 0xeb, 0xc0, 0xb4, 0x5b, 0x8c, 0xfc, 0x5c, 0xbc, 0x10, 0xd7,
+	0x56, 0x40, 0xdc, 0xeb, 0xa4, 0x7b, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e,
+	0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51,
+	0xc2, 0x28, 0x0a, 0xf5, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0xae, 0x32, 0x06, 0x04,
+	0x00, 0x00, 0xff, 0xff, 0x73, 0x2a, 0x50, 0xfa, 0xf4, 0x00, 0x00, 0x00,
 }
 
 func (m *Module) Marshal() (dAtA []byte, err error) {
@@ -113,6 +126,15 @@ func (m *Module) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.HooksOrder) > 0 {
+		for iNdEx := len(m.HooksOrder) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.HooksOrder[iNdEx])
+			copy(dAtA[i:], m.HooksOrder[iNdEx])
+			i = encodeVarintModule(dAtA, i, uint64(len(m.HooksOrder[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Authority) > 0 {
 		i -= len(m.Authority)
 		copy(dAtA[i:], m.Authority)
@@ -144,6 +166,12 @@ func (m *Module) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovModule(uint64(l))
 	}
+	if len(m.HooksOrder) > 0 {
+		for _, s := range m.HooksOrder {
+			l = len(s)
+			n += 1 + l + sovModule(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -214,6 +242,38 @@ func (m *Module) Unmarshal(dAtA []byte) error {
 			}
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HooksOrder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HooksOrder = append(m.HooksOrder, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModule(dAtA[iNdEx:])
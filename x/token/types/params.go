@@ -1,17 +1,130 @@
 package types
 
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // NewParams creates a new Params instance.
-func NewParams() Params {
-	return Params{}
+func NewParams(feeTokens []FeeToken, nativeFeeDenom string, wrappableDenoms []WrappableDenom) Params {
+	return Params{
+		FeeTokens:       feeTokens,
+		NativeFeeDenom:  nativeFeeDenom,
+		WrappableDenoms: wrappableDenoms,
+	}
 }
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(nil, sdk.DefaultBondDenom, nil)
 }
 
 // Validate validates the set of params.
 func (p Params) Validate() error {
+	if len(p.FeeTokens) > 0 {
+		if err := sdk.ValidateDenom(p.NativeFeeDenom); err != nil {
+			return fmt.Errorf("invalid native fee denom: %w", err)
+		}
+	}
+
+	seen := make(map[string]bool, len(p.FeeTokens))
+	for _, feeToken := range p.FeeTokens {
+		if err := feeToken.Validate(); err != nil {
+			return err
+		}
+		if feeToken.Denom == p.NativeFeeDenom {
+			return fmt.Errorf("fee token %s cannot be the native fee denom", feeToken.Denom)
+		}
+		if seen[feeToken.Denom] {
+			return fmt.Errorf("duplicated fee token %s", feeToken.Denom)
+		}
+		seen[feeToken.Denom] = true
+	}
+
+	wrapped := make(map[string]bool, 2*len(p.WrappableDenoms))
+	for _, wrappable := range p.WrappableDenoms {
+		if err := wrappable.Validate(); err != nil {
+			return err
+		}
+		if wrapped[wrappable.Denom] || wrapped[wrappable.Symbol] {
+			return fmt.Errorf("duplicated wrappable denom %s (%s)", wrappable.Denom, wrappable.Symbol)
+		}
+		wrapped[wrappable.Denom] = true
+		wrapped[wrappable.Symbol] = true
+	}
+
+	return nil
+}
+
+// WrappableDenom returns the wrapping configuration of an underlying denom.
+func (p Params) WrappableDenom(denom string) (WrappableDenom, bool) {
+	for _, wrappable := range p.WrappableDenoms {
+		if wrappable.Denom == denom {
+			return wrappable, true
+		}
+	}
+	return WrappableDenom{}, false
+}
+
+// WrappableDenomBySymbol returns the wrapping configuration of a wrapped token symbol.
+func (p Params) WrappableDenomBySymbol(symbol string) (WrappableDenom, bool) {
+	for _, wrappable := range p.WrappableDenoms {
+		if wrappable.Symbol == symbol {
+			return wrappable, true
+		}
+	}
+	return WrappableDenom{}, false
+}
+
+// FeeToken returns the fee token for the given denom, if it is whitelisted.
+func (p Params) FeeToken(denom string) (FeeToken, bool) {
+	for _, feeToken := range p.FeeTokens {
+		if feeToken.Denom == denom {
+			return feeToken, true
+		}
+	}
+	return FeeToken{}, false
+}
+
+// Validate validates a fee token.
+func (f FeeToken) Validate() error {
+	if err := sdk.ValidateDenom(f.Denom); err != nil {
+		return fmt.Errorf("invalid fee token denom: %w", err)
+	}
+	rate, err := f.ConversionRateDec()
+	if err != nil {
+		return err
+	}
+	if !rate.IsPositive() {
+		return fmt.Errorf("conversion rate of fee token %s must be positive", f.Denom)
+	}
+	return nil
+}
+
+// ConversionRateDec returns the conversion rate as a decimal.
+func (f FeeToken) ConversionRateDec() (math.LegacyDec, error) {
+	rate, err := math.LegacyNewDecFromStr(f.ConversionRate)
+	if err != nil {
+		return math.LegacyDec{}, fmt.Errorf("invalid conversion rate of fee token %s: %w", f.Denom, err)
+	}
+	return rate, nil
+}
 
+// Validate validates a wrappable denom.
+func (w WrappableDenom) Validate() error {
+	if err := sdk.ValidateDenom(w.Denom); err != nil {
+		return fmt.Errorf("invalid wrappable denom: %w", err)
+	}
+	if err := sdk.ValidateDenom(w.Symbol); err != nil {
+		return fmt.Errorf("invalid wrapped symbol of %s: %w", w.Denom, err)
+	}
+	if w.Denom == w.Symbol {
+		return fmt.Errorf("wrapped symbol of %s must differ from the underlying denom", w.Denom)
+	}
+	if w.Name == "" {
+		return fmt.Errorf("wrapped token name of %s cannot be empty", w.Denom)
+	}
 	return nil
 }
@@ -302,6 +302,186 @@ func (m *QueryAllTokenResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryGetMintLimitRequest defines the QueryGetMintLimitRequest message.
+type QueryGetMintLimitRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryGetMintLimitRequest) Reset()         { *m = QueryGetMintLimitRequest{} }
+func (m *QueryGetMintLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMintLimitRequest) ProtoMessage()    {}
+func (*QueryGetMintLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28285e0a575c6db7, []int{6}
+}
+func (m *QueryGetMintLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetMintLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetMintLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetMintLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMintLimitRequest.Merge(m, src)
+}
+func (m *QueryGetMintLimitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetMintLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMintLimitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetMintLimitRequest proto.InternalMessageInfo
+
+func (m *QueryGetMintLimitRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryGetMintLimitResponse defines the QueryGetMintLimitResponse message.
+type QueryGetMintLimitResponse struct {
+	MintLimit MintLimit `protobuf:"bytes,1,opt,name=mint_limit,json=mintLimit,proto3" json:"mint_limit"`
+}
+
+func (m *QueryGetMintLimitResponse) Reset()         { *m = QueryGetMintLimitResponse{} }
+func (m *QueryGetMintLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMintLimitResponse) ProtoMessage()    {}
+func (*QueryGetMintLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28285e0a575c6db7, []int{7}
+}
+func (m *QueryGetMintLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetMintLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetMintLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetMintLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMintLimitResponse.Merge(m, src)
+}
+func (m *QueryGetMintLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetMintLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMintLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetMintLimitResponse proto.InternalMessageInfo
+
+func (m *QueryGetMintLimitResponse) GetMintLimit() MintLimit {
+	if m != nil {
+		return m.MintLimit
+	}
+	return MintLimit{}
+}
+
+// QueryGetWrappedTokenRequest defines the QueryGetWrappedTokenRequest message.
+type QueryGetWrappedTokenRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryGetWrappedTokenRequest) Reset()         { *m = QueryGetWrappedTokenRequest{} }
+func (m *QueryGetWrappedTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetWrappedTokenRequest) ProtoMessage()    {}
+func (*QueryGetWrappedTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28285e0a575c6db7, []int{8}
+}
+func (m *QueryGetWrappedTokenRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetWrappedTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetWrappedTokenRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetWrappedTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetWrappedTokenRequest.Merge(m, src)
+}
+func (m *QueryGetWrappedTokenRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetWrappedTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetWrappedTokenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetWrappedTokenRequest proto.InternalMessageInfo
+
+func (m *QueryGetWrappedTokenRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryGetWrappedTokenResponse defines the QueryGetWrappedTokenResponse message.
+type QueryGetWrappedTokenResponse struct {
+	Token Token `protobuf:"bytes,1,opt,name=token,proto3" json:"token"`
+}
+
+func (m *QueryGetWrappedTokenResponse) Reset()         { *m = QueryGetWrappedTokenResponse{} }
+func (m *QueryGetWrappedTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetWrappedTokenResponse) ProtoMessage()    {}
+func (*QueryGetWrappedTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28285e0a575c6db7, []int{9}
+}
+func (m *QueryGetWrappedTokenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetWrappedTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetWrappedTokenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetWrappedTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetWrappedTokenResponse.Merge(m, src)
+}
+func (m *QueryGetWrappedTokenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetWrappedTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetWrappedTokenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetWrappedTokenResponse proto.InternalMessageInfo
+
+func (m *QueryGetWrappedTokenResponse) GetToken() Token {
+	if m != nil {
+		return m.Token
+	}
+	return Token{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "omnis.token.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "omnis.token.v1.QueryParamsResponse")
@@ -309,43 +489,56 @@ func init() {
 	proto.RegisterType((*QueryGetTokenResponse)(nil), "omnis.token.v1.QueryGetTokenResponse")
 	proto.RegisterType((*QueryAllTokenRequest)(nil), "omnis.token.v1.QueryAllTokenRequest")
 	proto.RegisterType((*QueryAllTokenResponse)(nil), "omnis.token.v1.QueryAllTokenResponse")
+	proto.RegisterType((*QueryGetMintLimitRequest)(nil), "omnis.token.v1.QueryGetMintLimitRequest")
+	proto.RegisterType((*QueryGetMintLimitResponse)(nil), "omnis.token.v1.QueryGetMintLimitResponse")
+	proto.RegisterType((*QueryGetWrappedTokenRequest)(nil), "omnis.token.v1.QueryGetWrappedTokenRequest")
+	proto.RegisterType((*QueryGetWrappedTokenResponse)(nil), "omnis.token.v1.QueryGetWrappedTokenResponse")
 }
 
 func init() { proto.RegisterFile("omnis/token/v1/query.proto", fileDescriptor_28285e0a575c6db7) }
 
 var fileDescriptor_28285e0a575c6db7 = []byte{
-	// 484 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x41, 0x6b, 0x14, 0x31,
-	0x14, 0xc7, 0x77, 0xc6, 0x76, 0xb1, 0x11, 0x0a, 0xa6, 0xbb, 0xb5, 0x8c, 0x75, 0x94, 0xa8, 0x55,
-	0x0a, 0x26, 0x4c, 0x3d, 0x79, 0x74, 0x0f, 0x16, 0xc4, 0xc3, 0x3a, 0x78, 0xf2, 0x20, 0x64, 0xdd,
-	0x30, 0x04, 0x77, 0x93, 0xd9, 0x4d, 0xba, 0x5a, 0x8a, 0x17, 0x3f, 0x81, 0xd0, 0x2f, 0xe1, 0xd1,
-	0x8f, 0xd1, 0x63, 0xc1, 0x8b, 0x27, 0x91, 0x5d, 0xc1, 0xab, 0x1f, 0x41, 0xe6, 0x25, 0xa5, 0x9d,
-	0x74, 0x6c, 0xbd, 0x0c, 0x21, 0xef, 0xff, 0xde, 0xef, 0x3f, 0xf3, 0x7f, 0x83, 0x12, 0x3d, 0x56,
-	0xd2, 0x30, 0xab, 0xdf, 0x09, 0xc5, 0x66, 0x19, 0x9b, 0xec, 0x89, 0xe9, 0x3e, 0x2d, 0xa7, 0xda,
-	0x6a, 0xbc, 0x0a, 0x35, 0x0a, 0x35, 0x3a, 0xcb, 0x92, 0xeb, 0x7c, 0x2c, 0x95, 0x66, 0xf0, 0x74,
-	0x92, 0x64, 0xfb, 0xad, 0x36, 0x63, 0x6d, 0xd8, 0x80, 0x1b, 0xe1, 0x7a, 0xd9, 0x2c, 0x1b, 0x08,
-	0xcb, 0x33, 0x56, 0xf2, 0x42, 0x2a, 0x6e, 0xa5, 0x56, 0x5e, 0xdb, 0x29, 0x74, 0xa1, 0xe1, 0xc8,
-	0xaa, 0x93, 0xbf, 0xdd, 0x2c, 0xb4, 0x2e, 0x46, 0x82, 0xf1, 0x52, 0x32, 0xae, 0x94, 0xb6, 0xd0,
-	0x62, 0x7c, 0xf5, 0x66, 0x60, 0xaf, 0xe4, 0x53, 0x3e, 0x3e, 0x29, 0x86, 0xde, 0x9d, 0x51, 0xa8,
-	0x91, 0x0e, 0xc2, 0x2f, 0x2b, 0x3b, 0x7d, 0x68, 0xc8, 0xc5, 0x64, 0x4f, 0x18, 0x4b, 0xfa, 0x68,
-	0xad, 0x76, 0x6b, 0x4a, 0xad, 0x8c, 0xc0, 0x4f, 0x50, 0xdb, 0x0d, 0xde, 0x88, 0xee, 0x44, 0x0f,
-	0xaf, 0xed, 0xac, 0xd3, 0xfa, 0x9b, 0x53, 0xa7, 0xef, 0xad, 0x1c, 0xfd, 0xb8, 0xdd, 0xfa, 0xf2,
-	0xfb, 0xeb, 0x76, 0x94, 0xfb, 0x06, 0xb2, 0x85, 0x3a, 0x30, 0x71, 0x57, 0xd8, 0x57, 0x95, 0xda,
-	0x93, 0xf0, 0x2a, 0x8a, 0xe5, 0x10, 0xc6, 0x2d, 0xe5, 0xb1, 0x1c, 0x92, 0xe7, 0xa8, 0x1b, 0xe8,
-	0x3c, 0x3b, 0x43, 0xcb, 0x80, 0xf1, 0xe8, 0x6e, 0x88, 0x06, 0x75, 0x6f, 0xa9, 0x22, 0xe7, 0x4e,
-	0x49, 0xde, 0x78, 0xe6, 0xd3, 0xd1, 0xa8, 0xc6, 0x7c, 0x86, 0xd0, 0xe9, 0x47, 0xf7, 0xf3, 0xb6,
-	0xa8, 0x4b, 0x88, 0x56, 0x09, 0x51, 0x97, 0xae, 0x4f, 0x88, 0xf6, 0x79, 0x21, 0x7c, 0x6f, 0x7e,
-	0xa6, 0x93, 0x1c, 0x46, 0xde, 0xec, 0x29, 0xe0, 0xbc, 0xd9, 0x2b, 0xff, 0x67, 0x16, 0xef, 0xd6,
-	0x4c, 0xc5, 0x60, 0xea, 0xc1, 0xa5, 0xa6, 0x1c, 0xef, 0xac, 0xab, 0x9d, 0x3f, 0x31, 0x5a, 0x06,
-	0x57, 0x78, 0x82, 0xda, 0x2e, 0x10, 0x4c, 0x42, 0x03, 0xe7, 0x33, 0x4f, 0xee, 0x5e, 0xa8, 0x71,
-	0x20, 0x92, 0x7e, 0xfa, 0xf6, 0xeb, 0x30, 0xde, 0xc0, 0xeb, 0xac, 0x71, 0xe1, 0xf0, 0x01, 0xba,
-	0x7a, 0x92, 0x1c, 0xbe, 0xd7, 0x38, 0x30, 0x58, 0x80, 0xe4, 0xfe, 0x25, 0x2a, 0x0f, 0x26, 0x00,
-	0xde, 0xc4, 0x09, 0x6b, 0x5a, 0x66, 0x76, 0x20, 0x87, 0x1f, 0xf1, 0x7b, 0xb4, 0xf2, 0x42, 0x9a,
-	0x0b, 0xe9, 0xc1, 0x2a, 0xfc, 0x83, 0x1e, 0xe6, 0x49, 0x6e, 0x01, 0xfd, 0x06, 0xee, 0x36, 0xd2,
-	0x7b, 0x8f, 0x8e, 0xe6, 0x69, 0x74, 0x3c, 0x4f, 0xa3, 0x9f, 0xf3, 0x34, 0xfa, 0xbc, 0x48, 0x5b,
-	0xc7, 0x8b, 0xb4, 0xf5, 0x7d, 0x91, 0xb6, 0x5e, 0xaf, 0x39, 0xfd, 0x07, 0xdf, 0x61, 0xf7, 0x4b,
-	0x61, 0x06, 0x6d, 0xf8, 0xf5, 0x1e, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x38, 0xe0, 0xff, 0x54,
-	0x54, 0x04, 0x00, 0x00,
+	// 637 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xc1, 0x6b, 0x13, 0x4f,
+	0x14, 0xce, 0xe6, 0xd7, 0x94, 0x5f, 0x9e, 0x52, 0x71, 0x9a, 0xd6, 0x76, 0x5b, 0xb7, 0x3a, 0x6a,
+	0x5b, 0x57, 0xdd, 0x31, 0xed, 0xc9, 0x83, 0x82, 0x39, 0x58, 0x90, 0x0a, 0xe9, 0x22, 0x08, 0x0a,
+	0x96, 0x8d, 0x19, 0x96, 0xc1, 0xec, 0xce, 0x26, 0xbb, 0x4d, 0x2d, 0xa5, 0x17, 0xcf, 0x1e, 0x84,
+	0xde, 0xc5, 0xa3, 0x47, 0xff, 0x8c, 0x1e, 0x0b, 0x5e, 0x3c, 0x89, 0x24, 0x82, 0xff, 0x86, 0xec,
+	0xcc, 0xc4, 0x74, 0xa7, 0x9b, 0x36, 0x78, 0x29, 0xdb, 0x99, 0xef, 0x7d, 0xdf, 0x37, 0xef, 0x7d,
+	0x2f, 0x60, 0xf2, 0x20, 0x64, 0x31, 0x49, 0xf8, 0x5b, 0x1a, 0x92, 0x6e, 0x95, 0xb4, 0x77, 0x68,
+	0x67, 0xcf, 0x89, 0x3a, 0x3c, 0xe1, 0x68, 0x4a, 0xdc, 0x39, 0xe2, 0xce, 0xe9, 0x56, 0xcd, 0xcb,
+	0x5e, 0xc0, 0x42, 0x4e, 0xc4, 0x5f, 0x09, 0x31, 0xed, 0x37, 0x3c, 0x0e, 0x78, 0x4c, 0x1a, 0x5e,
+	0x4c, 0x65, 0x2d, 0xe9, 0x56, 0x1b, 0x34, 0xf1, 0xaa, 0x24, 0xf2, 0x7c, 0x16, 0x7a, 0x09, 0xe3,
+	0xa1, 0xc2, 0x56, 0x7c, 0xee, 0x73, 0xf1, 0x49, 0xd2, 0x2f, 0x75, 0xba, 0xe8, 0x73, 0xee, 0xb7,
+	0x28, 0xf1, 0x22, 0x46, 0xbc, 0x30, 0xe4, 0x89, 0x28, 0x89, 0xd5, 0xed, 0x82, 0x66, 0x2f, 0xf2,
+	0x3a, 0x5e, 0x30, 0xb8, 0xd4, 0xbd, 0x4b, 0xa3, 0xe2, 0x0e, 0x57, 0x00, 0x6d, 0xa5, 0x76, 0xea,
+	0xa2, 0xc0, 0xa5, 0xed, 0x1d, 0x1a, 0x27, 0xb8, 0x0e, 0xd3, 0x99, 0xd3, 0x38, 0xe2, 0x61, 0x4c,
+	0xd1, 0x03, 0x98, 0x94, 0xc4, 0x73, 0xc6, 0x35, 0x63, 0xf5, 0xc2, 0xda, 0xac, 0x93, 0x7d, 0xb9,
+	0x23, 0xf1, 0xb5, 0xf2, 0xd1, 0x8f, 0xa5, 0xc2, 0x97, 0xdf, 0x5f, 0x6d, 0xc3, 0x55, 0x05, 0x78,
+	0x19, 0x2a, 0x82, 0x71, 0x83, 0x26, 0xcf, 0x53, 0xb4, 0x52, 0x42, 0x53, 0x50, 0x64, 0x4d, 0x41,
+	0x37, 0xe1, 0x16, 0x59, 0x13, 0x3f, 0x85, 0x19, 0x0d, 0xa7, 0xb4, 0xab, 0x50, 0x12, 0x32, 0x4a,
+	0x7a, 0x46, 0x97, 0x16, 0xe8, 0xda, 0x44, 0xaa, 0xec, 0x4a, 0x24, 0x7e, 0xad, 0x34, 0x1f, 0xb7,
+	0x5a, 0x19, 0xcd, 0x27, 0x00, 0xc3, 0xa6, 0x2b, 0xbe, 0x65, 0x47, 0x4e, 0xc8, 0x49, 0x27, 0xe4,
+	0xc8, 0xe9, 0xaa, 0x09, 0x39, 0x75, 0xcf, 0xa7, 0xaa, 0xd6, 0x3d, 0x51, 0x89, 0x0f, 0x0d, 0x65,
+	0x76, 0x28, 0x70, 0xda, 0xec, 0x7f, 0xe3, 0x99, 0x45, 0x1b, 0x19, 0x53, 0x45, 0x61, 0x6a, 0xe5,
+	0x5c, 0x53, 0x52, 0x2f, 0xe3, 0xca, 0x86, 0xb9, 0x41, 0x07, 0x9f, 0xb1, 0x30, 0xd9, 0x64, 0x01,
+	0x4b, 0x46, 0x75, 0xfb, 0x15, 0xcc, 0xe7, 0x60, 0xd5, 0x23, 0x1e, 0x01, 0x04, 0x2c, 0x4c, 0xb6,
+	0x5b, 0xe9, 0xa9, 0x6a, 0xd3, 0xbc, 0xfe, 0x92, 0xbf, 0x65, 0xea, 0x35, 0xe5, 0x60, 0x70, 0x80,
+	0xd7, 0x61, 0x61, 0x40, 0xfe, 0xa2, 0xe3, 0x45, 0x11, 0x6d, 0x66, 0xa6, 0x50, 0x81, 0x52, 0x93,
+	0x86, 0x3c, 0x10, 0xcc, 0x65, 0x57, 0xfe, 0x83, 0xb7, 0x60, 0x31, 0xbf, 0xe8, 0x9f, 0x63, 0xb0,
+	0xf6, 0xb9, 0x04, 0x25, 0xc1, 0x89, 0xda, 0x30, 0x29, 0x13, 0x8a, 0xb0, 0x5e, 0x77, 0x7a, 0x09,
+	0xcc, 0x1b, 0x67, 0x62, 0xa4, 0x1f, 0x6c, 0xbd, 0xff, 0xf6, 0xeb, 0xb0, 0x38, 0x87, 0x66, 0x49,
+	0xee, 0x06, 0xa2, 0x7d, 0xf8, 0x7f, 0x10, 0x65, 0x74, 0x33, 0x97, 0x50, 0xdb, 0x08, 0xf3, 0xd6,
+	0x39, 0x28, 0x25, 0x8c, 0x85, 0xf0, 0x22, 0x32, 0x49, 0xde, 0x76, 0x93, 0x7d, 0xd6, 0x3c, 0x40,
+	0xbb, 0x50, 0xde, 0x64, 0xf1, 0x99, 0xea, 0xda, 0x6e, 0x8c, 0x50, 0xd7, 0x03, 0x8e, 0xaf, 0x0a,
+	0xf5, 0x2b, 0x68, 0x26, 0x57, 0x1d, 0x7d, 0x30, 0xe0, 0xe2, 0xc9, 0x4c, 0xa1, 0xd5, 0x51, 0x8f,
+	0xd2, 0x23, 0x6a, 0xde, 0x1e, 0x03, 0xa9, 0x4c, 0xac, 0x08, 0x13, 0xd7, 0xd1, 0x92, 0x6e, 0x62,
+	0x18, 0x5b, 0xd9, 0x87, 0x4f, 0x06, 0x5c, 0xd2, 0x02, 0x85, 0xee, 0x8c, 0xd2, 0xc9, 0xc9, 0xaa,
+	0x79, 0x77, 0x3c, 0xb0, 0xf2, 0x75, 0x5f, 0xf8, 0xb2, 0xd1, 0xaa, 0xee, 0x6b, 0x57, 0xa2, 0xb7,
+	0xd5, 0x88, 0x44, 0xe2, 0x1f, 0xda, 0xf6, 0x41, 0xed, 0xde, 0x51, 0xcf, 0x32, 0x8e, 0x7b, 0x96,
+	0xf1, 0xb3, 0x67, 0x19, 0x1f, 0xfb, 0x56, 0xe1, 0xb8, 0x6f, 0x15, 0xbe, 0xf7, 0xad, 0xc2, 0xcb,
+	0x69, 0x49, 0xf1, 0x4e, 0x91, 0x24, 0x7b, 0x11, 0x8d, 0x1b, 0x93, 0xe2, 0xb7, 0x7b, 0xfd, 0x4f,
+	0x00, 0x00, 0x00, 0xff, 0xff, 0xee, 0x62, 0xe2, 0x89, 0x95, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -366,6 +559,10 @@ type QueryClient interface {
 	GetToken(ctx context.Context, in *QueryGetTokenRequest, opts ...grpc.CallOption) (*QueryGetTokenResponse, error)
 	// ListToken defines the ListToken RPC.
 	ListToken(ctx context.Context, in *QueryAllTokenRequest, opts ...grpc.CallOption) (*QueryAllTokenResponse, error)
+	// GetMintLimit queries the per-epoch mint limit of a token.
+	GetMintLimit(ctx context.Context, in *QueryGetMintLimitRequest, opts ...grpc.CallOption) (*QueryGetMintLimitResponse, error)
+	// GetWrappedToken queries the registry token a native or IBC denom is wrapped into.
+	GetWrappedToken(ctx context.Context, in *QueryGetWrappedTokenRequest, opts ...grpc.CallOption) (*QueryGetWrappedTokenResponse, error)
 }
 
 type queryClient struct {
@@ -403,6 +600,24 @@ func (c *queryClient) ListToken(ctx context.Context, in *QueryAllTokenRequest, o
 	return out, nil
 }
 
+func (c *queryClient) GetMintLimit(ctx context.Context, in *QueryGetMintLimitRequest, opts ...grpc.CallOption) (*QueryGetMintLimitResponse, error) {
+	out := new(QueryGetMintLimitResponse)
+	err := c.cc.Invoke(ctx, "/omnis.token.v1.Query/GetMintLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetWrappedToken(ctx context.Context, in *QueryGetWrappedTokenRequest, opts ...grpc.CallOption) (*QueryGetWrappedTokenResponse, error) {
+	out := new(QueryGetWrappedTokenResponse)
+	err := c.cc.Invoke(ctx, "/omnis.token.v1.Query/GetWrappedToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -411,6 +626,10 @@ type QueryServer interface {
 	GetToken(context.Context, *QueryGetTokenRequest) (*QueryGetTokenResponse, error)
 	// ListToken defines the ListToken RPC.
 	ListToken(context.Context, *QueryAllTokenRequest) (*QueryAllTokenResponse, error)
+	// GetMintLimit queries the per-epoch mint limit of a token.
+	GetMintLimit(context.Context, *QueryGetMintLimitRequest) (*QueryGetMintLimitResponse, error)
+	// GetWrappedToken queries the registry token a native or IBC denom is wrapped into.
+	GetWrappedToken(context.Context, *QueryGetWrappedTokenRequest) (*QueryGetWrappedTokenResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -426,6 +645,12 @@ func (*UnimplementedQueryServer) GetToken(ctx context.Context, req *QueryGetToke
 func (*UnimplementedQueryServer) ListToken(ctx context.Context, req *QueryAllTokenRequest) (*QueryAllTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListToken not implemented")
 }
+func (*UnimplementedQueryServer) GetMintLimit(ctx context.Context, req *QueryGetMintLimitRequest) (*QueryGetMintLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMintLimit not implemented")
+}
+func (*UnimplementedQueryServer) GetWrappedToken(ctx context.Context, req *QueryGetWrappedTokenRequest) (*QueryGetWrappedTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWrappedToken not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -485,6 +710,42 @@ func _Query_ListToken_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetMintLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMintLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetMintLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/omnis.token.v1.Query/GetMintLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetMintLimit(ctx, req.(*QueryGetMintLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetWrappedToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetWrappedTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetWrappedToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/omnis.token.v1.Query/GetWrappedToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetWrappedToken(ctx, req.(*QueryGetWrappedTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "omnis.token.v1.Query",
@@ -502,6 +763,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListToken",
 			Handler:    _Query_ListToken_Handler,
 		},
+		{
+			MethodName: "GetMintLimit",
+			Handler:    _Query_GetMintLimit_Handler,
+		},
+		{
+			MethodName: "GetWrappedToken",
+			Handler:    _Query_GetWrappedToken_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "omnis/token/v1/query.proto",
@@ -708,6 +977,130 @@ func (m *QueryAllTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryGetMintLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMintLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMintLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMintLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMintLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMintLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MintLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetWrappedTokenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetWrappedTokenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetWrappedTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetWrappedTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetWrappedTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetWrappedTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -794,13 +1187,193 @@ func (m *QueryAllTokenResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryGetMintLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryGetMintLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MintLimit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetWrappedTokenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetWrappedTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Token.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTokenRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -823,12 +1396,31 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetTokenRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -850,7 +1442,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetTokenResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -873,15 +1465,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetTokenResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -908,7 +1500,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -933,7 +1525,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTokenRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllTokenRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -956,17 +1548,17 @@ func (m *QueryGetTokenRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTokenRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllTokenRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -976,11 +1568,28 @@ func (m *QueryGetTokenRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1002,7 +1611,7 @@ func (m *QueryGetTokenRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTokenResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllTokenResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1025,10 +1634,10 @@ func (m *QueryGetTokenResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTokenResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllTokenResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1060,7 +1669,44 @@ func (m *QueryGetTokenResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Token = append(m.Token, Token{})
+			if err := m.Token[len(m.Token)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1085,7 +1731,7 @@ func (m *QueryGetTokenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllTokenRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetMintLimitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1108,15 +1754,84 @@ func (m *QueryAllTokenRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllTokenRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetMintLimitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetMintLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMintLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMintLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMintLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MintLimit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1143,10 +1858,7 @@ func (m *QueryAllTokenRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MintLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1171,7 +1883,7 @@ func (m *QueryAllTokenRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllTokenResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetWrappedTokenRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1194,17 +1906,17 @@ func (m *QueryAllTokenResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllTokenResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetWrappedTokenRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetWrappedTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1214,29 +1926,77 @@ func (m *QueryAllTokenResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Token = append(m.Token, Token{})
-			if err := m.Token[len(m.Token)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetWrappedTokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetWrappedTokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetWrappedTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1263,10 +2023,7 @@ func (m *QueryAllTokenResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -115,29 +115,164 @@ func (m *Token) GetCreator() string {
 	return ""
 }
 
+// MintLimit caps the amount of a token its admin may mint within one epoch of
+// the x/epochs module. The minted counter is reset when the epoch ends.
+type MintLimit struct {
+	TokenId uint64 `protobuf:"varint,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	// epoch_identifier is the x/epochs identifier (e.g. "day") the limit is tracked against.
+	EpochIdentifier string `protobuf:"bytes,2,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// max_amount is the maximum amount that may be minted per epoch.
+	MaxAmount string `protobuf:"bytes,3,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`
+	// minted is the amount minted so far in the current epoch.
+	Minted string `protobuf:"bytes,4,opt,name=minted,proto3" json:"minted,omitempty"`
+}
+
+func (m *MintLimit) Reset()         { *m = MintLimit{} }
+func (m *MintLimit) String() string { return proto.CompactTextString(m) }
+func (*MintLimit) ProtoMessage()    {}
+func (*MintLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4321a8453fdd8756, []int{1}
+}
+func (m *MintLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintLimit.Merge(m, src)
+}
+func (m *MintLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintLimit proto.InternalMessageInfo
+
+func (m *MintLimit) GetTokenId() uint64 {
+	if m != nil {
+		return m.TokenId
+	}
+	return 0
+}
+
+func (m *MintLimit) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+func (m *MintLimit) GetMaxAmount() string {
+	if m != nil {
+		return m.MaxAmount
+	}
+	return ""
+}
+
+func (m *MintLimit) GetMinted() string {
+	if m != nil {
+		return m.Minted
+	}
+	return ""
+}
+
+// WrappedToken maps a wrapped native or IBC denom to its registry token.
+type WrappedToken struct {
+	Denom   string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	TokenId uint64 `protobuf:"varint,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+}
+
+func (m *WrappedToken) Reset()         { *m = WrappedToken{} }
+func (m *WrappedToken) String() string { return proto.CompactTextString(m) }
+func (*WrappedToken) ProtoMessage()    {}
+func (*WrappedToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4321a8453fdd8756, []int{2}
+}
+func (m *WrappedToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WrappedToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WrappedToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WrappedToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WrappedToken.Merge(m, src)
+}
+func (m *WrappedToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *WrappedToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_WrappedToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WrappedToken proto.InternalMessageInfo
+
+func (m *WrappedToken) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *WrappedToken) GetTokenId() uint64 {
+	if m != nil {
+		return m.TokenId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Token)(nil), "omnis.token.v1.Token")
+	proto.RegisterType((*MintLimit)(nil), "omnis.token.v1.MintLimit")
+	proto.RegisterType((*WrappedToken)(nil), "omnis.token.v1.WrappedToken")
 }
 
 func init() { proto.RegisterFile("omnis/token/v1/token.proto", fileDescriptor_4321a8453fdd8756) }
 
 var fileDescriptor_4321a8453fdd8756 = []byte{
-	// 237 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0x90, 0x31, 0x4e, 0xc3, 0x40,
-	0x10, 0x45, 0xbd, 0xc6, 0x71, 0x60, 0x81, 0x14, 0x83, 0x84, 0x46, 0x29, 0x56, 0x86, 0xca, 0x0d,
-	0x89, 0x22, 0x6e, 0xc0, 0x11, 0x0c, 0x15, 0x0d, 0xda, 0xc4, 0x5b, 0x58, 0x78, 0xbd, 0x2b, 0xef,
-	0x10, 0xe1, 0x5b, 0x70, 0x1c, 0x8e, 0x40, 0x99, 0x92, 0x12, 0xd9, 0x17, 0x41, 0x19, 0x27, 0xe9,
-	0xfe, 0x7b, 0x5f, 0xbf, 0xf9, 0x72, 0xee, 0x6c, 0x53, 0x85, 0x25, 0xb9, 0x77, 0xd3, 0x2c, 0xb7,
-	0xab, 0x31, 0x2c, 0x7c, 0xeb, 0xc8, 0xc1, 0x8c, 0xbb, 0xc5, 0xa8, 0xb6, 0xab, 0xfb, 0x6f, 0x21,
-	0x27, 0x2f, 0x7b, 0x80, 0x99, 0x8c, 0xab, 0x12, 0x45, 0x26, 0xf2, 0xa4, 0x88, 0xab, 0x12, 0x40,
-	0x26, 0x8d, 0xb6, 0x06, 0xe3, 0x4c, 0xe4, 0x17, 0x05, 0x67, 0xb8, 0x95, 0x69, 0xe8, 0xec, 0xda,
-	0xd5, 0x78, 0xc6, 0xf6, 0x40, 0x30, 0x97, 0xe7, 0xa5, 0xd9, 0x54, 0x56, 0xd7, 0x01, 0x93, 0x4c,
-	0xe4, 0xd7, 0xc5, 0x89, 0xe1, 0x4e, 0x5e, 0x91, 0x23, 0x5d, 0xbf, 0x85, 0x0f, 0xef, 0xeb, 0x0e,
-	0x27, 0xbc, 0xbc, 0x64, 0xf7, 0xcc, 0x6a, 0x3f, 0xb7, 0x86, 0x74, 0xa9, 0x49, 0x63, 0xca, 0xf5,
-	0x89, 0x01, 0xe5, 0x74, 0xd3, 0x1a, 0x4d, 0xae, 0xc5, 0x29, 0x57, 0x47, 0x7c, 0x7a, 0xf8, 0xe9,
-	0x95, 0xd8, 0xf5, 0x4a, 0xfc, 0xf5, 0x4a, 0x7c, 0x0d, 0x2a, 0xda, 0x0d, 0x2a, 0xfa, 0x1d, 0x54,
-	0xf4, 0x7a, 0x33, 0x1e, 0xf0, 0x79, 0xb8, 0x80, 0x3a, 0x6f, 0xc2, 0x3a, 0xe5, 0x03, 0x1e, 0xff,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0x72, 0x6c, 0x37, 0x53, 0x1e, 0x01, 0x00, 0x00,
+	// 343 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0x4f, 0x4a, 0x03, 0x31,
+	0x14, 0xc6, 0x9b, 0xb1, 0xff, 0xe6, 0x59, 0xab, 0x44, 0x91, 0x58, 0x70, 0xa8, 0x5d, 0xd5, 0x85,
+	0x2d, 0xc5, 0x03, 0x88, 0xee, 0x0a, 0xba, 0x19, 0x05, 0xc1, 0xcd, 0x90, 0x36, 0x11, 0x83, 0x93,
+	0x64, 0x98, 0x49, 0x4b, 0x7b, 0x03, 0x97, 0x1e, 0xc7, 0x23, 0xb8, 0xec, 0xd2, 0xa5, 0xb4, 0x17,
+	0x91, 0xbe, 0x19, 0x07, 0xba, 0xcb, 0xef, 0xf7, 0x78, 0xf0, 0xbe, 0x7c, 0xd0, 0xb1, 0xda, 0xa8,
+	0x6c, 0xe8, 0xec, 0xbb, 0x34, 0xc3, 0xf9, 0x28, 0x7f, 0x0c, 0x92, 0xd4, 0x3a, 0x4b, 0xdb, 0x38,
+	0x1b, 0xe4, 0x6a, 0x3e, 0xea, 0x7d, 0x11, 0xa8, 0x3d, 0x6d, 0x81, 0xb6, 0xc1, 0x53, 0x82, 0x91,
+	0x2e, 0xe9, 0x57, 0x43, 0x4f, 0x09, 0x4a, 0xa1, 0x6a, 0xb8, 0x96, 0xcc, 0xeb, 0x92, 0xbe, 0x1f,
+	0xe2, 0x9b, 0x9e, 0x42, 0x3d, 0x5b, 0xea, 0x89, 0x8d, 0xd9, 0x1e, 0xda, 0x82, 0x68, 0x07, 0x9a,
+	0x42, 0x4e, 0x95, 0xe6, 0x71, 0xc6, 0xaa, 0x5d, 0xd2, 0x3f, 0x08, 0x4b, 0xa6, 0x17, 0xd0, 0x72,
+	0xd6, 0xf1, 0x38, 0xca, 0x66, 0x49, 0x12, 0x2f, 0x59, 0x0d, 0x37, 0xf7, 0xd1, 0x3d, 0xa2, 0xda,
+	0xae, 0x6b, 0xe9, 0xb8, 0xe0, 0x8e, 0xb3, 0x3a, 0x8e, 0x4b, 0xa6, 0x0c, 0x1a, 0xd3, 0x54, 0x72,
+	0x67, 0x53, 0xd6, 0xc0, 0xd1, 0x3f, 0xf6, 0x3e, 0x08, 0xf8, 0x0f, 0xca, 0xb8, 0x7b, 0xa5, 0x95,
+	0xa3, 0x67, 0xd0, 0xc4, 0x50, 0x51, 0x19, 0xa2, 0x81, 0x3c, 0x16, 0xf4, 0x12, 0x8e, 0x64, 0x62,
+	0xa7, 0x6f, 0x91, 0x12, 0xd2, 0x38, 0xf5, 0xaa, 0x64, 0x5a, 0xa4, 0x3a, 0x44, 0x3f, 0x2e, 0x35,
+	0x3d, 0x07, 0xd0, 0x7c, 0x11, 0x71, 0x6d, 0x67, 0xc6, 0x15, 0x21, 0x7d, 0xcd, 0x17, 0xb7, 0x28,
+	0xb6, 0xf9, 0xb5, 0x32, 0x4e, 0x0a, 0x4c, 0xe9, 0x87, 0x05, 0xf5, 0x6e, 0xa0, 0xf5, 0x9c, 0xf2,
+	0x24, 0x91, 0x22, 0xff, 0xcb, 0x13, 0xa8, 0x09, 0x69, 0xac, 0xc6, 0x4b, 0xfc, 0x30, 0x87, 0x9d,
+	0x13, 0xbd, 0x9d, 0x13, 0xef, 0xae, 0xbe, 0xd7, 0x01, 0x59, 0xad, 0x03, 0xf2, 0xbb, 0x0e, 0xc8,
+	0xe7, 0x26, 0xa8, 0xac, 0x36, 0x41, 0xe5, 0x67, 0x13, 0x54, 0x5e, 0x8e, 0xf3, 0x32, 0x17, 0x45,
+	0x9d, 0x6e, 0x99, 0xc8, 0x6c, 0x52, 0xc7, 0x32, 0xaf, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xae,
+	0x91, 0x7b, 0xe2, 0xea, 0x01, 0x00, 0x00,
 }
 
 func (m *Token) Marshal() (dAtA []byte, err error) {
@@ -208,6 +343,90 @@ func (m *Token) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MintLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Minted) > 0 {
+		i -= len(m.Minted)
+		copy(dAtA[i:], m.Minted)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Minted)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MaxAmount) > 0 {
+		i -= len(m.MaxAmount)
+		copy(dAtA[i:], m.MaxAmount)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.MaxAmount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TokenId != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.TokenId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WrappedToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WrappedToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WrappedToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TokenId != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.TokenId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintToken(dAtA []byte, offset int, v uint64) int {
 	offset -= sovToken(v)
 	base := offset
@@ -254,6 +473,46 @@ func (m *Token) Size() (n int) {
 	return n
 }
 
+func (m *MintLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TokenId != 0 {
+		n += 1 + sovToken(uint64(m.TokenId))
+	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.MaxAmount)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Minted)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	return n
+}
+
+func (m *WrappedToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if m.TokenId != 0 {
+		n += 1 + sovToken(uint64(m.TokenId))
+	}
+	return n
+}
+
 func sovToken(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -508,6 +767,272 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MintLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			m.TokenId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokenId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minted = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WrappedToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WrappedToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WrappedToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			m.TokenId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokenId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipToken(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0